@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"fmt"
 	"os"
 
 	// Uncomment to load all auth plugins
@@ -15,30 +16,43 @@ import (
 	// _ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
 	// _ "k8s.io/client-go/plugin/pkg/client/auth/openstack"
 
-	containerKill "github.com/litmuschaos/litmus-go/chaoslib/litmus/container-kill/helper"
-	diskFill "github.com/litmuschaos/litmus-go/chaoslib/litmus/disk-fill/helper"
-	httpChaos "github.com/litmuschaos/litmus-go/chaoslib/litmus/http-chaos/helper"
-	networkChaos "github.com/litmuschaos/litmus-go/chaoslib/litmus/network-chaos/helper"
-	dnsChaos "github.com/litmuschaos/litmus-go/chaoslib/litmus/pod-dns-chaos/helper"
-	stressChaos "github.com/litmuschaos/litmus-go/chaoslib/litmus/stress-chaos/helper"
+	// helpers register themselves with the registry on import,
+	// add a blank import here to make a new helper available
+	_ "github.com/litmuschaos/litmus-go/chaoslib/litmus/container-kill/helper"
+	_ "github.com/litmuschaos/litmus-go/chaoslib/litmus/disk-fill/helper"
+	_ "github.com/litmuschaos/litmus-go/chaoslib/litmus/http-chaos/helper"
+	_ "github.com/litmuschaos/litmus-go/chaoslib/litmus/network-chaos/helper"
+	_ "github.com/litmuschaos/litmus-go/chaoslib/litmus/pod-dns-chaos/helper"
+	_ "github.com/litmuschaos/litmus-go/chaoslib/litmus/revert/helper"
+	_ "github.com/litmuschaos/litmus-go/chaoslib/litmus/stress-chaos/helper"
 	cli "github.com/litmuschaos/litmus-go/pkg/clients"
 	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/registry"
 	"github.com/litmuschaos/litmus-go/pkg/telemetry"
-	"github.com/sirupsen/logrus"
+	"github.com/litmuschaos/litmus-go/pkg/types"
 	"go.opentelemetry.io/otel"
 )
 
 func init() {
-	// Log as JSON instead of the default ASCII formatter
-	logrus.SetFormatter(&logrus.TextFormatter{
-		FullTimestamp:          true,
-		DisableSorting:         true,
-		DisableLevelTruncation: true,
-	})
+	// the logs are formatted as text unless LOG_FORMAT=json is provided
+	log.SetFormat(os.Getenv(log.LogFormatENV))
 }
 
 func main() {
 	ctx := context.Background()
+
+	// parse the helper name
+	helperName := flag.String("name", "", "name of the helper pod")
+	listHelpers := flag.Bool("list", false, "list the available helpers")
+	flag.Parse()
+
+	if *listHelpers {
+		for _, entry := range registry.Helpers.List() {
+			fmt.Printf("%-20s %s\n", entry.Name, entry.Description)
+		}
+		return
+	}
+
 	// Set up Observability.
 	if otelExporterEndpoint := os.Getenv(telemetry.OTELExporterOTLPEndpoint); otelExporterEndpoint != "" {
 		shutdown, err := telemetry.InitOTelSDK(ctx, true, otelExporterEndpoint)
@@ -56,9 +70,13 @@ func main() {
 
 	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "ExecuteExperimentHelper")
 	defer span.End()
+	log.SetTraceContext(ctx)
 
-	// parse the helper name
-	helperName := flag.String("name", "", "name of the helper pod")
+	// the helper pods inherit the experiment fields of the logs from the experiment pod
+	log.SetField(log.ExperimentField, os.Getenv("EXPERIMENT_NAME"))
+	log.SetField(log.EngineField, os.Getenv("CHAOSENGINE"))
+	log.SetField(log.RunIDField, os.Getenv("CHAOS_UID"))
+	log.SetField(log.PhaseField, string(types.ChaosInjectPhase))
 
 	//Getting kubeConfig and Generate ClientSets
 	if err := clients.GenerateClientSetFromKubeConfig(); err != nil {
@@ -69,22 +87,10 @@ func main() {
 	log.Infof("Helper Name: %v", *helperName)
 
 	// invoke the corresponding helper based on the the (-name) flag
-	switch *helperName {
-	case "container-kill":
-		containerKill.Helper(ctx, clients)
-	case "disk-fill":
-		diskFill.Helper(ctx, clients)
-	case "dns-chaos":
-		dnsChaos.Helper(ctx, clients)
-	case "stress-chaos":
-		stressChaos.Helper(ctx, clients)
-	case "network-chaos":
-		networkChaos.Helper(ctx, clients)
-	case "http-chaos":
-		httpChaos.Helper(ctx, clients)
-
-	default:
+	helper, ok := registry.Helpers.Get(*helperName)
+	if !ok {
 		log.Errorf("Unsupported -name %v, please provide the correct value of -name args", *helperName)
 		return
 	}
+	helper.Run(ctx, clients)
 }
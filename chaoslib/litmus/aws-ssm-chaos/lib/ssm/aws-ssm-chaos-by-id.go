@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 
 	"github.com/litmuschaos/litmus-go/chaoslib/litmus/aws-ssm-chaos/lib"
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/aws-ssm/aws-ssm-chaos/types"
@@ -32,15 +30,19 @@ func PrepareAWSSSMChaosByID(ctx context.Context, experimentsDetails *experimentT
 	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "PrepareAWSSSMFaultByID")
 	defer span.End()
 
+	if chaosDetails.DryRun {
+		return planAWSSSMChaosByID(experimentsDetails, chaosDetails)
+	}
+
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -59,9 +61,9 @@ func PrepareAWSSSMChaosByID(ctx context.Context, experimentsDetails *experimentT
 	go lib.AbortWatcher(experimentsDetails, abort)
 
 	//get the instance id or list of instance ids
-	instanceIDList := stringutils.SplitList(experimentsDetails.EC2InstanceID)
-	if len(instanceIDList) == 0 {
-		return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "no instance id found for chaos injection"}
+	instanceIDList, err := getTargetInstanceIDs(experimentsDetails, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
 	}
 
 	switch strings.ToLower(experimentsDetails.Sequence) {
@@ -90,3 +92,31 @@ func PrepareAWSSSMChaosByID(ctx context.Context, experimentsDetails *experimentT
 	}
 	return nil
 }
+
+// getTargetInstanceIDs returns the instance ids provided for the chaos, once they pass the guardrails
+func getTargetInstanceIDs(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) ([]string, error) {
+	instanceIDList := stringutils.SplitList(experimentsDetails.EC2InstanceID)
+	if len(instanceIDList) == 0 {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "no instance id found for chaos injection"}
+	}
+	if err := common.CheckInstanceGuardrails("instances", instanceIDList, 0, chaosDetails); err != nil {
+		return nil, err
+	}
+	return instanceIDList, nil
+}
+
+// planAWSSSMChaosByID records the run of the ssm document on the given instances inside the plan, without running it
+func planAWSSSMChaosByID(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) error {
+	targets, err := getTargetInstanceIDs(experimentsDetails, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
+	}
+	for _, target := range targets {
+		common.RecordPlanStep("run ssm document", target, map[string]string{
+			"document": experimentsDetails.DocumentName,
+			"region":   experimentsDetails.Region,
+			"sequence": experimentsDetails.Sequence,
+		}, chaosDetails)
+	}
+	return nil
+}
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 
 	"github.com/litmuschaos/litmus-go/chaoslib/litmus/aws-ssm-chaos/lib"
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/aws-ssm/aws-ssm-chaos/types"
@@ -26,15 +24,19 @@ func PrepareAWSSSMChaosByTag(ctx context.Context, experimentsDetails *experiment
 	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "InjectAWSSSMFaultByTag")
 	defer span.End()
 
+	if chaosDetails.DryRun {
+		return planAWSSSMChaosByTag(experimentsDetails, chaosDetails)
+	}
+
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -51,12 +53,11 @@ func PrepareAWSSSMChaosByTag(ctx context.Context, experimentsDetails *experiment
 
 	// watching for the abort signal and revert the chaos
 	go lib.AbortWatcher(experimentsDetails, abort)
-	instanceIDList := common.FilterBasedOnPercentage(experimentsDetails.InstanceAffectedPerc, experimentsDetails.TargetInstanceIDList)
-	log.Infof("[Chaos]:Number of Instance targeted: %v", len(instanceIDList))
-
-	if len(instanceIDList) == 0 {
-		return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "no instance id found for chaos injection"}
+	instanceIDList, err := selectTargetInstances(experimentsDetails, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
 	}
+	log.Infof("[Chaos]:Number of Instance targeted: %v", len(instanceIDList))
 
 	switch strings.ToLower(experimentsDetails.Sequence) {
 	case "serial":
@@ -84,3 +85,31 @@ func PrepareAWSSSMChaosByTag(ctx context.Context, experimentsDetails *experiment
 	}
 	return nil
 }
+
+// selectTargetInstances selects the target instances out of the instances matching the tag, based on the affected percentage
+func selectTargetInstances(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) ([]string, error) {
+	instanceIDList := common.FilterBasedOnPercentage(experimentsDetails.InstanceAffectedPerc, experimentsDetails.TargetInstanceIDList)
+	if err := common.CheckInstanceGuardrails("instances", instanceIDList, len(experimentsDetails.TargetInstanceIDList), chaosDetails); err != nil {
+		return nil, err
+	}
+	if len(instanceIDList) == 0 {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "no instance id found for chaos injection"}
+	}
+	return instanceIDList, nil
+}
+
+// planAWSSSMChaosByTag records the run of the ssm document on the target instances inside the plan, without running it
+func planAWSSSMChaosByTag(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) error {
+	targets, err := selectTargetInstances(experimentsDetails, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
+	}
+	for _, target := range targets {
+		common.RecordPlanStep("run ssm document", target, map[string]string{
+			"document": experimentsDetails.DocumentName,
+			"region":   experimentsDetails.Region,
+			"sequence": experimentsDetails.Sequence,
+		}, chaosDetails)
+	}
+	return nil
+}
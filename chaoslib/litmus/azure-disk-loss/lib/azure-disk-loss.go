@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
@@ -40,12 +38,12 @@ func PrepareChaos(ctx context.Context, experimentsDetails *experimentTypes.Exper
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -58,6 +56,9 @@ func PrepareChaos(ctx context.Context, experimentsDetails *experimentTypes.Exper
 	if len(diskNameList) == 0 {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "no volume names found to detach"}
 	}
+	if err := common.CheckInstanceGuardrails("volumes", diskNameList, 0, chaosDetails); err != nil {
+		return stacktrace.Propagate(err, "could not select target volumes")
+	}
 	instanceNamesWithDiskNames, err := diskStatus.GetInstanceNameForDisks(diskNameList, experimentsDetails.SubscriptionID, experimentsDetails.ResourceGroup)
 
 	if err != nil {
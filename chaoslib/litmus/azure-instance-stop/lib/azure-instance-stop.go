@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/azure/instance-stop/types"
@@ -38,11 +36,11 @@ func PrepareAzureStop(ctx context.Context, experimentsDetails *experimentTypes.E
 	// inject channel is used to transmit signal notifications
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	// Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -55,6 +53,9 @@ func PrepareAzureStop(ctx context.Context, experimentsDetails *experimentTypes.E
 	if len(instanceNameList) == 0 {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "no instance name found to stop"}
 	}
+	if err := common.CheckInstanceGuardrails("instances", instanceNameList, 0, chaosDetails); err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
+	}
 
 	// watching for the abort signal and revert the chaos
 	go abortWatcher(experimentsDetails, instanceNameList)
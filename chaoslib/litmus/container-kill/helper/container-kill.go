@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/litmuschaos/litmus-go/pkg/registry"
 	"github.com/litmuschaos/litmus-go/pkg/telemetry"
 	"go.opentelemetry.io/otel"
 
@@ -27,11 +28,15 @@ import (
 	clientTypes "k8s.io/apimachinery/pkg/types"
 )
 
+func init() {
+	registry.RegisterHelper("container-kill", "Kills the target containers", Helper)
+}
+
 var err error
 
 // Helper injects the container-kill chaos
 func Helper(ctx context.Context, clients clients.ClientSets) {
-	_, span := otel.Tracer(telemetry.TracerName).Start(ctx, "SimulateContainerKillFault")
+	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "SimulateContainerKillFault")
 	defer span.End()
 
 	experimentsDetails := experimentTypes.ExperimentDetails{}
@@ -44,13 +49,15 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 	getENV(&experimentsDetails)
 
 	// Initialise the chaos attributes
-	types.InitialiseChaosVariables(&chaosDetails)
+	if err := types.InitialiseChaosVariables(&chaosDetails); err != nil {
+		log.Fatalf("helper pod failed, err: %v", err)
+	}
 	chaosDetails.Phase = types.ChaosInjectPhase
 
 	// Initialise Chaos Result Parameters
 	types.SetResultAttributes(&resultDetails, chaosDetails)
 
-	if err := killContainer(&experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails); err != nil {
+	if err := killContainer(ctx, &experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails); err != nil {
 		// update failstep inside chaosresult
 		if resultErr := result.UpdateFailedStepFromHelper(&resultDetails, &chaosDetails, clients, err); resultErr != nil {
 			log.Fatalf("helper pod failed, err: %v, resultErr: %v", err, resultErr)
@@ -62,7 +69,7 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 // killContainer kill the random application container
 // it will kill the container till the chaos duration
 // the execution will stop after timestamp passes the given chaos duration
-func killContainer(experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
+func killContainer(ctx context.Context, experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
 	targetList, err := common.ParseTargets(chaosDetails.ChaosPodName)
 	if err != nil {
 		return stacktrace.Propagate(err, "could not parse targets")
@@ -78,7 +85,7 @@ func killContainer(experimentsDetails *experimentTypes.ExperimentDetails, client
 			Source:          chaosDetails.ChaosPodName,
 		}
 		targets = append(targets, td)
-		log.Infof("Injecting chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer)
+		log.InfoWithTarget(ctx, "pod/"+t.Name, fmt.Sprintf("Injecting chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer), nil)
 	}
 
 	if err := killIterations(targets, experimentsDetails, clients, eventsDetails, chaosDetails, resultDetails); err != nil {
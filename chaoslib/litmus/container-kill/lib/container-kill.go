@@ -3,7 +3,6 @@ package lib
 import (
 	"context"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
@@ -49,7 +48,7 @@ func PrepareContainerKill(ctx context.Context, experimentsDetails *experimentTyp
 	}
 
 	//Waiting for the ramp time before chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time before injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
@@ -62,7 +61,7 @@ func PrepareContainerKill(ctx context.Context, experimentsDetails *experimentTyp
 		}
 	}
 
-	if experimentsDetails.EngineName != "" {
+	if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 		if err := common.SetHelperData(chaosDetails, experimentsDetails.SetHelperData, clients); err != nil {
 			return stacktrace.Propagate(err, "could not set helper data")
 		}
@@ -83,7 +82,7 @@ func PrepareContainerKill(ctx context.Context, experimentsDetails *experimentTyp
 	}
 
 	//Waiting for the ramp time after chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time after injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
@@ -228,7 +227,9 @@ func createHelperPod(ctx context.Context, experimentsDetails *experimentTypes.Ex
 		helperPod.Spec.Volumes = append(helperPod.Spec.Volumes, common.GetSidecarVolumes(chaosDetails)...)
 	}
 
-	if err := clients.CreatePod(experimentsDetails.ChaosNamespace, helperPod); err != nil {
+	common.MountOTelExporterSecret(helperPod)
+
+	if err := common.CreateHelperPod(experimentsDetails.ChaosNamespace, helperPod, chaosDetails, clients); err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("unable to create helper pod: %s", err.Error())}
 	}
 
@@ -253,7 +254,8 @@ func getPodEnv(ctx context.Context, experimentsDetails *experimentTypes.Experime
 		SetEnv("EXPERIMENT_NAME", experimentsDetails.ExperimentName).
 		SetEnv("CONTAINER_API_TIMEOUT", strconv.Itoa(experimentsDetails.ContainerAPITimeout)).
 		SetEnv("INSTANCE_ID", experimentsDetails.InstanceID).
-		SetEnv("OTEL_EXPORTER_OTLP_ENDPOINT", os.Getenv(telemetry.OTELExporterOTLPEndpoint)).
+		SetOTelExporterEnv().
+		SetLogFormatEnv().
 		SetEnv("TRACE_PARENT", telemetry.GetMarshalledSpanFromContext(ctx)).
 		SetEnvFromDownwardAPI("v1", "metadata.name")
 
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/registry"
 	"github.com/litmuschaos/litmus-go/pkg/telemetry"
 	"github.com/palantir/stacktrace"
 	"go.opentelemetry.io/otel"
@@ -29,6 +30,10 @@ import (
 	clientTypes "k8s.io/apimachinery/pkg/types"
 )
 
+func init() {
+	registry.RegisterHelper("disk-fill", "Fills up the ephemeral storage of the target containers", Helper)
+}
+
 var inject, abort chan os.Signal
 
 // Helper injects the disk-fill chaos
@@ -56,7 +61,9 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 	getENV(&experimentsDetails)
 
 	// Intialise the chaos attributes
-	types.InitialiseChaosVariables(&chaosDetails)
+	if err := types.InitialiseChaosVariables(&chaosDetails); err != nil {
+		log.Fatalf("helper pod failed, err: %v", err)
+	}
 	chaosDetails.Phase = types.ChaosInjectPhase
 
 	// Intialise Chaos Result Parameters
@@ -65,7 +72,7 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 	// Set the chaos result uid
 	result.SetResultUID(&resultDetails, clients, &chaosDetails)
 
-	if err := diskFill(&experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails); err != nil {
+	if err := diskFill(ctx, &experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails); err != nil {
 		// update failstep inside chaosresult
 		if resultErr := result.UpdateFailedStepFromHelper(&resultDetails, &chaosDetails, clients, err); resultErr != nil {
 			log.Fatalf("helper pod failed, err: %v, resultErr: %v", err, resultErr)
@@ -75,7 +82,7 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 }
 
 // diskFill contains steps to inject disk-fill chaos
-func diskFill(experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
+func diskFill(ctx context.Context, experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
 
 	targetList, err := common.ParseTargets(chaosDetails.ChaosPodName)
 	if err != nil {
@@ -127,7 +134,7 @@ func diskFill(experimentsDetails *experimentTypes.ExperimentDetails, clients cli
 	}
 
 	// watching for the abort signal and revert the chaos
-	go abortWatcher(targets, experimentsDetails, clients, resultDetails.Name)
+	go abortWatcher(ctx, targets, experimentsDetails, clients, resultDetails.Name)
 
 	select {
 	case <-inject:
@@ -141,9 +148,9 @@ func diskFill(experimentsDetails *experimentTypes.ExperimentDetails, clients cli
 			if err := fillDisk(t, experimentsDetails.DataBlockSize); err != nil {
 				return stacktrace.Propagate(err, "could not fill ephemeral storage")
 			}
-			log.Infof("successfully injected chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer)
+			log.InfoWithTarget(ctx, "pod/"+t.Name, fmt.Sprintf("successfully injected chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer), nil)
 			if err = result.AnnotateChaosResult(resultDetails.Name, chaosDetails.ChaosNamespace, "injected", "pod", t.Name); err != nil {
-				if revertErr := revertDiskFill(t, clients); revertErr != nil {
+				if revertErr := revertDiskFill(ctx, t, clients); revertErr != nil {
 					return cerrors.PreserveError{ErrString: fmt.Sprintf("[%s,%s]", stacktrace.RootCause(err).Error(), stacktrace.RootCause(revertErr).Error())}
 				}
 				return stacktrace.Propagate(err, "could not annotate chaosresult")
@@ -164,7 +171,7 @@ func diskFill(experimentsDetails *experimentTypes.ExperimentDetails, clients cli
 	for _, t := range targets {
 		// It will delete the target pod if target pod is evicted
 		// if target pod is still running then it will delete all the files, which was created earlier during chaos execution
-		if err = revertDiskFill(t, clients); err != nil {
+		if err = revertDiskFill(ctx, t, clients); err != nil {
 			errList = append(errList, err.Error())
 			continue
 		}
@@ -251,7 +258,7 @@ func getSizeToBeFilled(experimentsDetails *experimentTypes.ExperimentDetails, us
 
 // revertDiskFill will delete the target pod if target pod is evicted
 // if target pod is still running then it will delete the files, which was created during chaos execution
-func revertDiskFill(t targetDetails, clients clients.ClientSets) error {
+func revertDiskFill(ctx context.Context, t targetDetails, clients clients.ClientSets) error {
 	pod, err := clients.GetPod(t.Namespace, t.Name, 180, 2)
 	if err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Source: t.Source, Target: fmt.Sprintf("{podName: %s,namespace: %s}", t.Name, t.Namespace), Reason: err.Error()}
@@ -273,7 +280,7 @@ func revertDiskFill(t targetDetails, clients clients.ClientSets) error {
 			return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Source: t.Source, Target: fmt.Sprintf("{podName: %s,namespace: %s}", t.Name, t.Namespace), Reason: fmt.Sprintf("failed to cleanup ephemeral storage: %s", string(out))}
 		}
 	}
-	log.Infof("successfully reverted chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer)
+	log.InfoWithTarget(ctx, "pod/"+t.Name, fmt.Sprintf("successfully reverted chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer), nil)
 	return nil
 }
 
@@ -294,7 +301,7 @@ func getENV(experimentDetails *experimentTypes.ExperimentDetails) {
 }
 
 // abortWatcher continuously watch for the abort signals
-func abortWatcher(targets []targetDetails, experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, resultName string) {
+func abortWatcher(ctx context.Context, targets []targetDetails, experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, resultName string) {
 	// waiting till the abort signal received
 	<-abort
 
@@ -304,7 +311,7 @@ func abortWatcher(targets []targetDetails, experimentsDetails *experimentTypes.E
 	retry := 3
 	for retry > 0 {
 		for _, t := range targets {
-			err := revertDiskFill(t, clients)
+			err := revertDiskFill(ctx, t, clients)
 			if err != nil {
 				log.Errorf("unable to kill disk-fill process, err :%v", err)
 				continue
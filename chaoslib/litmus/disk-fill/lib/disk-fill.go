@@ -3,7 +3,6 @@ package lib
 import (
 	"context"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
@@ -54,7 +53,7 @@ func PrepareDiskFill(ctx context.Context, experimentsDetails *experimentTypes.Ex
 	}
 
 	//Waiting for the ramp time before chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time before injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
@@ -67,7 +66,7 @@ func PrepareDiskFill(ctx context.Context, experimentsDetails *experimentTypes.Ex
 		}
 	}
 
-	if experimentsDetails.EngineName != "" {
+	if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 		if err := common.SetHelperData(chaosDetails, experimentsDetails.SetHelperData, clients); err != nil {
 			return stacktrace.Propagate(err, "could not set helper data")
 		}
@@ -88,7 +87,7 @@ func PrepareDiskFill(ctx context.Context, experimentsDetails *experimentTypes.Ex
 	}
 
 	//Waiting for the ramp time after chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time after injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
@@ -231,7 +230,9 @@ func createHelperPod(ctx context.Context, experimentsDetails *experimentTypes.Ex
 		helperPod.Spec.Volumes = append(helperPod.Spec.Volumes, common.GetSidecarVolumes(chaosDetails)...)
 	}
 
-	if err := clients.CreatePod(experimentsDetails.ChaosNamespace, helperPod); err != nil {
+	common.MountOTelExporterSecret(helperPod)
+
+	if err := common.CreateHelperPod(experimentsDetails.ChaosNamespace, helperPod, chaosDetails, clients); err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("unable to create helper pod: %s", err.Error())}
 	}
 
@@ -255,7 +256,8 @@ func getPodEnv(ctx context.Context, experimentsDetails *experimentTypes.Experime
 		SetEnv("INSTANCE_ID", experimentsDetails.InstanceID).
 		SetEnv("SOCKET_PATH", experimentsDetails.SocketPath).
 		SetEnv("CONTAINER_RUNTIME", experimentsDetails.ContainerRuntime).
-		SetEnv("OTEL_EXPORTER_OTLP_ENDPOINT", os.Getenv(telemetry.OTELExporterOTLPEndpoint)).
+		SetOTelExporterEnv().
+		SetLogFormatEnv().
 		SetEnv("TRACE_PARENT", telemetry.GetMarshalledSpanFromContext(ctx)).
 		SetEnvFromDownwardAPI("v1", "metadata.name")
 
@@ -28,12 +28,10 @@ func PrepareDockerServiceKill(ctx context.Context, experimentsDetails *experimen
 	defer span.End()
 
 	var err error
-	if experimentsDetails.TargetNode == "" {
-		//Select node for docker-service-kill
-		experimentsDetails.TargetNode, err = common.GetNodeName(experimentsDetails.AppNS, experimentsDetails.AppLabel, experimentsDetails.NodeLabel, clients)
-		if err != nil {
-			return stacktrace.Propagate(err, "could not get node name")
-		}
+	//Select node for docker-service-kill
+	experimentsDetails.TargetNode, err = common.GetNodeName(experimentsDetails.TargetNode, experimentsDetails.AppNS, experimentsDetails.AppLabel, experimentsDetails.NodeLabel, clients, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not get node name")
 	}
 
 	log.InfoWithValues("[Info]: Details of node under chaos injection", logrus.Fields{
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 
 	ebsloss "github.com/litmuschaos/litmus-go/chaoslib/litmus/ebs-loss/lib"
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -34,12 +32,12 @@ func PrepareEBSLossByID(ctx context.Context, experimentsDetails *experimentTypes
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -58,6 +56,9 @@ func PrepareEBSLossByID(ctx context.Context, experimentsDetails *experimentTypes
 		if len(volumeIDList) == 0 {
 			return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "no volume id found to detach"}
 		}
+		if err := common.CheckInstanceGuardrails("volumes", volumeIDList, 0, chaosDetails); err != nil {
+			return stacktrace.Propagate(err, "could not select target volumes")
+		}
 		// watching for the abort signal and revert the chaos
 		go ebsloss.AbortWatcher(experimentsDetails, volumeIDList, abort, chaosDetails)
 
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 
 	ebsloss "github.com/litmuschaos/litmus-go/chaoslib/litmus/ebs-loss/lib"
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -30,15 +28,19 @@ func PrepareEBSLossByTag(ctx context.Context, experimentsDetails *experimentType
 	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "PrepareAWSEBSLossFaultByTag")
 	defer span.End()
 
+	if chaosDetails.DryRun {
+		return planEBSLossByTag(experimentsDetails, chaosDetails)
+	}
+
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -52,7 +54,10 @@ func PrepareEBSLossByTag(ctx context.Context, experimentsDetails *experimentType
 		os.Exit(0)
 	default:
 
-		targetEBSVolumeIDList := common.FilterBasedOnPercentage(experimentsDetails.VolumeAffectedPerc, experimentsDetails.TargetVolumeIDList)
+		targetEBSVolumeIDList, err := selectTargetVolumes(experimentsDetails, chaosDetails)
+		if err != nil {
+			return stacktrace.Propagate(err, "could not select target volumes")
+		}
 		log.Infof("[Chaos]:Number of volumes targeted: %v", len(targetEBSVolumeIDList))
 
 		// watching for the abort signal and revert the chaos
@@ -78,3 +83,27 @@ func PrepareEBSLossByTag(ctx context.Context, experimentsDetails *experimentType
 	}
 	return nil
 }
+
+// selectTargetVolumes selects the target volumes out of the volumes matching the tag, based on the affected percentage
+func selectTargetVolumes(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) ([]string, error) {
+	targetEBSVolumeIDList := common.FilterBasedOnPercentage(experimentsDetails.VolumeAffectedPerc, experimentsDetails.TargetVolumeIDList)
+	if err := common.CheckInstanceGuardrails("volumes", targetEBSVolumeIDList, len(experimentsDetails.TargetVolumeIDList), chaosDetails); err != nil {
+		return nil, err
+	}
+	return targetEBSVolumeIDList, nil
+}
+
+// planEBSLossByTag records the detachment of the target volumes inside the plan, without detaching them
+func planEBSLossByTag(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) error {
+	targets, err := selectTargetVolumes(experimentsDetails, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not select target volumes")
+	}
+	for _, target := range targets {
+		common.RecordPlanStep("detach ebs volume", target, map[string]string{
+			"region":   experimentsDetails.Region,
+			"sequence": experimentsDetails.Sequence,
+		}, chaosDetails)
+	}
+	return nil
+}
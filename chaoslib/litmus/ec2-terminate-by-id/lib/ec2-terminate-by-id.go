@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -37,12 +35,12 @@ func PrepareEC2TerminateByID(ctx context.Context, experimentsDetails *experiment
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -55,6 +53,9 @@ func PrepareEC2TerminateByID(ctx context.Context, experimentsDetails *experiment
 	if len(instanceIDList) == 0 {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "no EC2 instance ID found to terminate"}
 	}
+	if err := common.CheckInstanceGuardrails("instances", instanceIDList, 0, chaosDetails); err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
+	}
 
 	// watching for the abort signal and revert the chaos
 	go abortWatcher(experimentsDetails, instanceIDList, chaosDetails)
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -31,15 +29,19 @@ func PrepareEC2TerminateByTag(ctx context.Context, experimentsDetails *experimen
 	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "PrepareAWSEC2TerminateFaultByTag")
 	defer span.End()
 
+	if chaosDetails.DryRun {
+		return planEC2TerminateByTag(experimentsDetails, chaosDetails)
+	}
+
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -47,7 +49,10 @@ func PrepareEC2TerminateByTag(ctx context.Context, experimentsDetails *experimen
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
 
-	instanceIDList := common.FilterBasedOnPercentage(experimentsDetails.InstanceAffectedPerc, experimentsDetails.TargetInstanceIDList)
+	instanceIDList, err := selectTargetInstances(experimentsDetails, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
+	}
 	log.Infof("[Chaos]:Number of Instance targeted: %v", len(instanceIDList))
 
 	// watching for the abort signal and revert the chaos
@@ -294,3 +299,27 @@ func abortWatcher(experimentsDetails *experimentTypes.ExperimentDetails, instanc
 	log.Info("[Abort]: Chaos Revert Completed")
 	os.Exit(1)
 }
+
+// selectTargetInstances selects the target instances out of the instances matching the tag, based on the affected percentage
+func selectTargetInstances(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) ([]string, error) {
+	instanceIDList := common.FilterBasedOnPercentage(experimentsDetails.InstanceAffectedPerc, experimentsDetails.TargetInstanceIDList)
+	if err := common.CheckInstanceGuardrails("instances", instanceIDList, len(experimentsDetails.TargetInstanceIDList), chaosDetails); err != nil {
+		return nil, err
+	}
+	return instanceIDList, nil
+}
+
+// planEC2TerminateByTag records the stop of the target instances inside the plan, without stopping them
+func planEC2TerminateByTag(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) error {
+	targets, err := selectTargetInstances(experimentsDetails, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
+	}
+	for _, target := range targets {
+		common.RecordPlanStep("stop ec2 instance", target, map[string]string{
+			"region":   experimentsDetails.Region,
+			"sequence": experimentsDetails.Sequence,
+		}, chaosDetails)
+	}
+	return nil
+}
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -37,12 +35,12 @@ func PrepareDiskVolumeLossByLabel(ctx context.Context, computeService *compute.S
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -51,6 +49,9 @@ func PrepareDiskVolumeLossByLabel(ctx context.Context, computeService *compute.S
 	}
 
 	diskVolumeNamesList := common.FilterBasedOnPercentage(experimentsDetails.DiskAffectedPerc, experimentsDetails.TargetDiskVolumeNamesList)
+	if err := common.CheckInstanceGuardrails("volumes", diskVolumeNamesList, len(experimentsDetails.TargetDiskVolumeNamesList), chaosDetails); err != nil {
+		return stacktrace.Propagate(err, "could not select target volumes")
+	}
 
 	if err := getDeviceNamesAndVMInstanceNames(diskVolumeNamesList, computeService, experimentsDetails); err != nil {
 		return err
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -39,12 +37,12 @@ func PrepareDiskVolumeLoss(ctx context.Context, computeService *compute.Service,
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -54,6 +52,9 @@ func PrepareDiskVolumeLoss(ctx context.Context, computeService *compute.Service,
 
 	//get the disk volume names list
 	diskNamesList := stringutils.SplitList(experimentsDetails.DiskVolumeNames)
+	if err := common.CheckInstanceGuardrails("volumes", diskNamesList, 0, chaosDetails); err != nil {
+		return stacktrace.Propagate(err, "could not select target volumes")
+	}
 
 	//get the disk zones list
 	diskZonesList := stringutils.SplitList(experimentsDetails.Zones)
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -31,15 +29,19 @@ func PrepareVMStopByLabel(ctx context.Context, computeService *compute.Service,
 	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "PrepareGCPVMInstanceStopFaultByLabel")
 	defer span.End()
 
+	if chaosDetails.DryRun {
+		return planVMStopByLabel(experimentsDetails, chaosDetails)
+	}
+
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -47,7 +49,10 @@ func PrepareVMStopByLabel(ctx context.Context, computeService *compute.Service,
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
 
-	instanceNamesList := common.FilterBasedOnPercentage(experimentsDetails.InstanceAffectedPerc, experimentsDetails.TargetVMInstanceNameList)
+	instanceNamesList, err := selectTargetInstances(experimentsDetails, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
+	}
 	log.Infof("[Chaos]:Number of Instance targeted: %v", len(instanceNamesList))
 
 	// watching for the abort signal and revert the chaos
@@ -291,3 +296,28 @@ func abortWatcher(computeService *compute.Service, experimentsDetails *experimen
 	log.Info("[Abort]: Chaos Revert Completed")
 	os.Exit(1)
 }
+
+// selectTargetInstances selects the target instances out of the instances matching the label, based on the affected percentage
+func selectTargetInstances(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) ([]string, error) {
+	instanceNamesList := common.FilterBasedOnPercentage(experimentsDetails.InstanceAffectedPerc, experimentsDetails.TargetVMInstanceNameList)
+	if err := common.CheckInstanceGuardrails("instances", instanceNamesList, len(experimentsDetails.TargetVMInstanceNameList), chaosDetails); err != nil {
+		return nil, err
+	}
+	return instanceNamesList, nil
+}
+
+// planVMStopByLabel records the stop of the target instances inside the plan, without stopping them
+func planVMStopByLabel(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) error {
+	targets, err := selectTargetInstances(experimentsDetails, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
+	}
+	for _, target := range targets {
+		common.RecordPlanStep("stop vm instance", target, map[string]string{
+			"project":  experimentsDetails.GCPProjectID,
+			"zones":    experimentsDetails.Zones,
+			"sequence": experimentsDetails.Sequence,
+		}, chaosDetails)
+	}
+	return nil
+}
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -38,12 +36,12 @@ func PrepareVMStop(ctx context.Context, computeService *compute.Service, experim
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	// waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -53,6 +51,9 @@ func PrepareVMStop(ctx context.Context, computeService *compute.Service, experim
 
 	// get the instance name or list of instance names
 	instanceNamesList := stringutils.SplitList(experimentsDetails.VMInstanceName)
+	if err := common.CheckInstanceGuardrails("instances", instanceNamesList, 0, chaosDetails); err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
+	}
 
 	// get the zone name or list of corresponding zones for the instances
 	instanceZonesList := stringutils.SplitList(experimentsDetails.Zones)
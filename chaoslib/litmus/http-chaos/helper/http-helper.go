@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/journal"
+	"github.com/litmuschaos/litmus-go/pkg/registry"
 	"github.com/litmuschaos/litmus-go/pkg/telemetry"
 	"github.com/palantir/stacktrace"
 	"go.opentelemetry.io/otel"
@@ -24,9 +26,18 @@ import (
 	clientTypes "k8s.io/apimachinery/pkg/types"
 )
 
+func init() {
+	registry.RegisterHelper("http-chaos", "Injects the http chaos inside the target containers", Helper)
+	journal.RegisterReverter(fault, revertJournalEntry)
+}
+
+// fault is the name of the http chaos inside the revert journal
+const fault = "http-chaos"
+
 var (
 	err           error
 	inject, abort chan os.Signal
+	revertJournal *journal.Journal
 )
 
 // Helper injects the http chaos
@@ -54,7 +65,9 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 	getENV(&experimentsDetails)
 
 	// Initialise the chaos attributes
-	types.InitialiseChaosVariables(&chaosDetails)
+	if err := types.InitialiseChaosVariables(&chaosDetails); err != nil {
+		log.Fatalf("helper pod failed, err: %v", err)
+	}
 	chaosDetails.Phase = types.ChaosInjectPhase
 
 	// Initialise Chaos Result Parameters
@@ -63,7 +76,7 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 	// Set the chaos result uid
 	result.SetResultUID(&resultDetails, clients, &chaosDetails)
 
-	err := prepareK8sHttpChaos(&experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails)
+	err := prepareK8sHttpChaos(ctx, &experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails)
 	if err != nil {
 		// update failstep inside chaosresult
 		if resultErr := result.UpdateFailedStepFromHelper(&resultDetails, &chaosDetails, clients, err); resultErr != nil {
@@ -74,7 +87,7 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 }
 
 // prepareK8sHttpChaos contains the preparation steps before chaos injection
-func prepareK8sHttpChaos(experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
+func prepareK8sHttpChaos(ctx context.Context, experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
 
 	targetList, err := common.ParseTargets(chaosDetails.ChaosPodName)
 	if err != nil {
@@ -104,8 +117,16 @@ func prepareK8sHttpChaos(experimentsDetails *experimentTypes.ExperimentDetails,
 		targets = append(targets, td)
 	}
 
+	// the revert journal keeps track of the injected faults, so that they are reverted even if the helper gets killed
+	if revertJournal, err = journal.New(clients, chaosDetails.ChaosNamespace, chaosDetails.ChaosPodName); err != nil {
+		log.Warnf("Unable to open the revert journal, err: %v", err)
+	}
+	if _, err := revertJournal.Reconcile(fault); err != nil {
+		log.Warnf("Unable to revert the leftover faults, err: %v", err)
+	}
+
 	// watching for the abort signal and revert the chaos
-	go abortWatcher(targets, resultDetails.Name, chaosDetails.ChaosNamespace, experimentsDetails)
+	go abortWatcher(ctx, targets, resultDetails.Name, chaosDetails.ChaosNamespace, experimentsDetails)
 
 	select {
 	case <-inject:
@@ -115,13 +136,16 @@ func prepareK8sHttpChaos(experimentsDetails *experimentTypes.ExperimentDetails,
 	}
 
 	for _, t := range targets {
+		if err := revertJournal.Record(journalEntry(t, experimentsDetails, resultDetails.Name)); err != nil {
+			log.Warnf("Unable to record the chaos inside the revert journal, err: %v", err)
+		}
 		// injecting http chaos inside target container
 		if err = injectChaos(experimentsDetails, t); err != nil {
 			return stacktrace.Propagate(err, "could not inject chaos")
 		}
-		log.Infof("successfully injected chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer)
+		log.InfoWithTarget(ctx, "pod/"+t.Name, fmt.Sprintf("successfully injected chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer), nil)
 		if err = result.AnnotateChaosResult(resultDetails.Name, chaosDetails.ChaosNamespace, "injected", "pod", t.Name); err != nil {
-			if revertErr := revertChaos(experimentsDetails, t); revertErr != nil {
+			if revertErr := revertChaos(ctx, experimentsDetails, t); revertErr != nil {
 				return cerrors.PreserveError{ErrString: fmt.Sprintf("[%s,%s]", stacktrace.RootCause(err).Error(), stacktrace.RootCause(revertErr).Error())}
 			}
 			return stacktrace.Propagate(err, "could not annotate chaosresult")
@@ -144,11 +168,12 @@ func prepareK8sHttpChaos(experimentsDetails *experimentTypes.ExperimentDetails,
 	var errList []string
 	for _, t := range targets {
 		// cleaning the ip rules process after chaos injection
-		err := revertChaos(experimentsDetails, t)
+		err := revertChaos(ctx, experimentsDetails, t)
 		if err != nil {
 			errList = append(errList, err.Error())
 			continue
 		}
+		removeJournalEntry(t)
 		if err = result.AnnotateChaosResult(resultDetails.Name, chaosDetails.ChaosNamespace, "reverted", "pod", t.Name); err != nil {
 			errList = append(errList, err.Error())
 		}
@@ -180,7 +205,7 @@ func injectChaos(experimentDetails *experimentTypes.ExperimentDetails, t targetD
 }
 
 // revertChaos revert the http chaos in target container
-func revertChaos(experimentDetails *experimentTypes.ExperimentDetails, t targetDetails) error {
+func revertChaos(ctx context.Context, experimentDetails *experimentTypes.ExperimentDetails, t targetDetails) error {
 
 	var errList []string
 
@@ -194,7 +219,7 @@ func revertChaos(experimentDetails *experimentTypes.ExperimentDetails, t targetD
 	if len(errList) != 0 {
 		return cerrors.PreserveError{ErrString: fmt.Sprintf("[%s]", strings.Join(errList, ","))}
 	}
-	log.Infof("successfully reverted chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer)
+	log.InfoWithTarget(ctx, "pod/"+t.Name, fmt.Sprintf("successfully reverted chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer), nil)
 	return nil
 }
 
@@ -206,7 +231,8 @@ func startProxy(experimentDetails *experimentTypes.ExperimentDetails, pid int) e
 	toxics := os.Getenv("TOXIC_COMMAND")
 
 	// starting toxiproxy server inside the target container
-	startProxyServerCommand := fmt.Sprintf("(sudo nsenter -t %d -n toxiproxy-server -host=0.0.0.0 > /dev/null 2>&1 &)", pid)
+	// the proxy server is marked by the ENV, so that the revert helper kills only the proxy servers of the helpers
+	startProxyServerCommand := fmt.Sprintf("(sudo %s=%s nsenter -t %d -n toxiproxy-server -host=0.0.0.0 > /dev/null 2>&1 &)", common.ChaosMarkEnv, common.ChaosMark(string(experimentDetails.ChaosUID)), pid)
 	// Creating a proxy for the targeted service in the target container
 	createProxyCommand := fmt.Sprintf("(sudo nsenter -t %d -n toxiproxy-cli create -l 0.0.0.0:%d -u 0.0.0.0:%d proxy)", pid, experimentDetails.ProxyPort, experimentDetails.TargetServicePort)
 	createToxicCommand := fmt.Sprintf("(sudo nsenter -t %d -n toxiproxy-cli toxic add %s --toxicity %f proxy)", pid, toxics, float32(experimentDetails.Toxicity)/100.0)
@@ -248,7 +274,7 @@ func addIPRuleSet(experimentDetails *experimentTypes.ExperimentDetails, pid int)
 	// it adds the proxy port REDIRECT iprule in the beginning of the PREROUTING table
 	// so that it always matches all the incoming packets for the matching target port filters and
 	// if matches then it redirect the request to the proxy port
-	addIPRuleSetCommand := fmt.Sprintf("(sudo nsenter -t %d -n iptables -t nat -I PREROUTING -i %v -p tcp --dport %d -j REDIRECT --to-port %d)", pid, experimentDetails.NetworkInterface, experimentDetails.TargetServicePort, experimentDetails.ProxyPort)
+	addIPRuleSetCommand := fmt.Sprintf("(sudo nsenter -t %d -n iptables -t nat -I %s)", pid, ipRuleSet(experimentDetails))
 	log.Infof("[Chaos]: Adding IPtables ruleset")
 
 	if err := common.RunBashCommand(addIPRuleSetCommand, "failed to add ip rules", experimentDetails.ChaosPodName); err != nil {
@@ -259,13 +285,24 @@ func addIPRuleSet(experimentDetails *experimentTypes.ExperimentDetails, pid int)
 	return nil
 }
 
+// ipRuleSet returns the proxy port REDIRECT rule of the PREROUTING chain
+// the rule is marked by a comment, so that the revert helper deletes only the rules of the helpers
+func ipRuleSet(experimentDetails *experimentTypes.ExperimentDetails) string {
+	rule := fmt.Sprintf("PREROUTING -i %v -p tcp --dport %d", experimentDetails.NetworkInterface, experimentDetails.TargetServicePort)
+	// the rules of the journal entries recorded before the mark was introduced don't contain the comment
+	if experimentDetails.ChaosUID != "" {
+		rule += " -m comment --comment " + common.ChaosMark(string(experimentDetails.ChaosUID))
+	}
+	return rule + fmt.Sprintf(" -j REDIRECT --to-port %d", experimentDetails.ProxyPort)
+}
+
 const NoIPRulesetToRemove = "No chain/target/match by that name"
 
 // removeIPRuleSet removes the ip rule set from iptables in target container
 // it is using nsenter command to enter into network namespace of target container
 // and execute the iptables related command inside it.
 func removeIPRuleSet(experimentDetails *experimentTypes.ExperimentDetails, pid int) error {
-	removeIPRuleSetCommand := fmt.Sprintf("sudo nsenter -t %d -n iptables -t nat -D PREROUTING -i %v -p tcp --dport %d -j REDIRECT --to-port %d", pid, experimentDetails.NetworkInterface, experimentDetails.TargetServicePort, experimentDetails.ProxyPort)
+	removeIPRuleSetCommand := fmt.Sprintf("sudo nsenter -t %d -n iptables -t nat -D %s", pid, ipRuleSet(experimentDetails))
 	log.Infof("[Chaos]: Removing IPtables ruleset")
 
 	if err := common.RunBashCommand(removeIPRuleSetCommand, "failed to remove ip rules", experimentDetails.ChaosPodName); err != nil {
@@ -294,7 +331,7 @@ func getENV(experimentDetails *experimentTypes.ExperimentDetails) {
 }
 
 // abortWatcher continuously watch for the abort signals
-func abortWatcher(targets []targetDetails, resultName, chaosNS string, experimentDetails *experimentTypes.ExperimentDetails) {
+func abortWatcher(ctx context.Context, targets []targetDetails, resultName, chaosNS string, experimentDetails *experimentTypes.ExperimentDetails) {
 
 	<-abort
 	log.Info("[Abort]: Killing process started because of terminated signal received")
@@ -303,13 +340,15 @@ func abortWatcher(targets []targetDetails, resultName, chaosNS string, experimen
 	retry := 3
 	for retry > 0 {
 		for _, t := range targets {
-			if err = revertChaos(experimentDetails, t); err != nil {
+			if err = revertChaos(ctx, experimentDetails, t); err != nil {
 				if strings.Contains(err.Error(), NoIPRulesetToRemove) && strings.Contains(err.Error(), NoProxyToKill) {
+					removeJournalEntry(t)
 					continue
 				}
 				log.Errorf("unable to revert for %v pod, err :%v", t.Name, err)
 				continue
 			}
+			removeJournalEntry(t)
 			if err = result.AnnotateChaosResult(resultName, chaosNS, "reverted", "pod", t.Name); err != nil {
 				log.Errorf("unable to annotate the chaosresult for %v pod, err :%v", t.Name, err)
 			}
@@ -322,6 +361,69 @@ func abortWatcher(targets []targetDetails, resultName, chaosNS string, experimen
 	os.Exit(1)
 }
 
+// journalEntry returns the revert journal entry of the given target
+func journalEntry(t targetDetails, experimentDetails *experimentTypes.ExperimentDetails, resultName string) journal.Entry {
+	return journal.Entry{
+		Fault:     fault,
+		Pod:       t.Name,
+		Namespace: t.Namespace,
+		Container: t.TargetContainer,
+		Result:    resultName,
+		Details: map[string]string{
+			"containerRuntime":  experimentDetails.ContainerRuntime,
+			"socketPath":        experimentDetails.SocketPath,
+			"containerID":       t.ContainerId,
+			"networkInterface":  experimentDetails.NetworkInterface,
+			"targetServicePort": strconv.Itoa(experimentDetails.TargetServicePort),
+			"proxyPort":         strconv.Itoa(experimentDetails.ProxyPort),
+			"chaosUID":          string(experimentDetails.ChaosUID),
+		},
+	}
+}
+
+// removeJournalEntry removes the entry of the given target from the revert journal, once the chaos is reverted
+func removeJournalEntry(t targetDetails) {
+	entry := journal.Entry{Fault: fault, Pod: t.Name, Namespace: t.Namespace, Container: t.TargetContainer}
+	if err := revertJournal.Remove(entry); err != nil {
+		log.Warnf("Unable to remove the chaos from the revert journal, err: %v", err)
+	}
+}
+
+// revertJournalEntry reverts the leftover http chaos of the given journal entry
+// the pid is derived again, as the pause process of the target is not the same across the helpers
+func revertJournalEntry(entry journal.Entry) error {
+	experimentDetails := &experimentTypes.ExperimentDetails{
+		ChaosPodName:     entry.HelperPod,
+		ContainerRuntime: entry.Details["containerRuntime"],
+		SocketPath:       entry.Details["socketPath"],
+		NetworkInterface: entry.Details["networkInterface"],
+		ChaosUID:         clientTypes.UID(entry.Details["chaosUID"]),
+	}
+	experimentDetails.TargetServicePort, _ = strconv.Atoi(entry.Details["targetServicePort"])
+	experimentDetails.ProxyPort, _ = strconv.Atoi(entry.Details["proxyPort"])
+
+	t := targetDetails{
+		Name:            entry.Pod,
+		Namespace:       entry.Namespace,
+		TargetContainer: entry.Container,
+		ContainerId:     entry.Details["containerID"],
+		Source:          entry.HelperPod,
+	}
+	t.Pid, err = common.GetPauseAndSandboxPID(experimentDetails.ContainerRuntime, t.ContainerId, experimentDetails.SocketPath, t.Source)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not get container pid")
+	}
+
+	// the proxy and the ip rules may be partially applied, the missing ones are already reverted
+	if err := removeIPRuleSet(experimentDetails, t.Pid); err != nil && !strings.Contains(err.Error(), NoIPRulesetToRemove) {
+		return err
+	}
+	if err := killProxy(t.Pid, t.Source); err != nil && !strings.Contains(err.Error(), NoProxyToKill) {
+		return err
+	}
+	return nil
+}
+
 type targetDetails struct {
 	Name            string
 	Namespace       string
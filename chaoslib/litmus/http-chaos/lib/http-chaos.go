@@ -3,7 +3,6 @@ package lib
 import (
 	"context"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
@@ -42,7 +41,7 @@ func PrepareAndInjectChaos(ctx context.Context, experimentsDetails *experimentTy
 	}
 
 	//Waiting for the ramp time before chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time before injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
@@ -55,7 +54,7 @@ func PrepareAndInjectChaos(ctx context.Context, experimentsDetails *experimentTy
 		}
 	}
 
-	if experimentsDetails.EngineName != "" {
+	if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 		if err := common.SetHelperData(chaosDetails, experimentsDetails.SetHelperData, clients); err != nil {
 			return stacktrace.Propagate(err, "could not set helper data")
 		}
@@ -227,7 +226,9 @@ func createHelperPod(ctx context.Context, experimentsDetails *experimentTypes.Ex
 		helperPod.Spec.Volumes = append(helperPod.Spec.Volumes, common.GetSidecarVolumes(chaosDetails)...)
 	}
 
-	if err := clients.CreatePod(experimentsDetails.ChaosNamespace, helperPod); err != nil {
+	common.MountOTelExporterSecret(helperPod)
+
+	if err := common.CreateHelperPod(experimentsDetails.ChaosNamespace, helperPod, chaosDetails, clients); err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("unable to create helper pod: %s", err.Error())}
 	}
 
@@ -251,7 +252,8 @@ func getPodEnv(ctx context.Context, experimentsDetails *experimentTypes.Experime
 		SetEnv("TARGET_SERVICE_PORT", strconv.Itoa(experimentsDetails.TargetServicePort)).
 		SetEnv("PROXY_PORT", strconv.Itoa(experimentsDetails.ProxyPort)).
 		SetEnv("TOXICITY", strconv.Itoa(experimentsDetails.Toxicity)).
-		SetEnv("OTEL_EXPORTER_OTLP_ENDPOINT", os.Getenv(telemetry.OTELExporterOTLPEndpoint)).
+		SetOTelExporterEnv().
+		SetLogFormatEnv().
 		SetEnv("TRACE_PARENT", telemetry.GetMarshalledSpanFromContext(ctx)).
 		SetEnvFromDownwardAPI("v1", "metadata.name")
 
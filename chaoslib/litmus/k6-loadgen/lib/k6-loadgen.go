@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path"
 	"strconv"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -86,7 +87,7 @@ func createHelperPod(ctx context.Context, experimentsDetails *experimentTypes.Ex
 	const volumeName = "script-volume"
 	const mountPath = "/mnt"
 
-	var envs []corev1.EnvVar
+	var envDetails common.ENVDetails
 	args := []string{
 		mountPath + "/" + experimentsDetails.ScriptSecretKey,
 		"-q",
@@ -97,20 +98,24 @@ func createHelperPod(ctx context.Context, experimentsDetails *experimentTypes.Ex
 	}
 
 	if otelExporterEndpoint := os.Getenv(telemetry.OTELExporterOTLPEndpoint); otelExporterEndpoint != "" {
-		envs = []corev1.EnvVar{
-			{
-				Name:  "K6_OTEL_METRIC_PREFIX",
-				Value: experimentsDetails.OTELMetricPrefix,
-			},
-			{
-				Name:  "K6_OTEL_GRPC_EXPORTER_INSECURE",
-				Value: "true",
-			},
-			{
-				Name:  "K6_OTEL_GRPC_EXPORTER_ENDPOINT",
-				Value: otelExporterEndpoint,
-			},
+		exporterConfig, err := telemetry.GetExporterConfig(otelExporterEndpoint)
+		if err != nil {
+			return stacktrace.Propagate(err, "could not derive the otlp exporter config")
 		}
+		// k6 reads its own exporter ENVs, which are derived from the OTLP exporter ENVs of the experiment
+		exporterType, exporterPrefix := "grpc", "K6_OTEL_GRPC_EXPORTER_"
+		if exporterConfig.Protocol == telemetry.ProtocolHTTPProtobuf {
+			exporterType, exporterPrefix = "http", "K6_OTEL_HTTP_EXPORTER_"
+			envDetails.SetEnv(exporterPrefix+"URL_PATH", path.Join("/", exporterConfig.URLPath, "v1/metrics"))
+		}
+		envDetails.SetEnv("K6_OTEL_METRIC_PREFIX", experimentsDetails.OTELMetricPrefix).
+			SetEnv("K6_OTEL_EXPORTER_TYPE", exporterType).
+			SetEnv(exporterPrefix+"INSECURE", strconv.FormatBool(exporterConfig.Insecure)).
+			SetEnv(exporterPrefix+"ENDPOINT", exporterConfig.Endpoint).
+			SetEnv("K6_OTEL_TLS_CERTIFICATE", exporterConfig.Certificate).
+			SetEnv("K6_OTEL_TLS_CLIENT_CERTIFICATE", exporterConfig.ClientCertificate).
+			SetEnv("K6_OTEL_TLS_CLIENT_KEY", exporterConfig.ClientKey).
+			SetEnv("K6_OTEL_HEADERS", exporterConfig.RawHeaders)
 		args = append(args, "--out", "experimental-opentelemetry")
 	}
 
@@ -134,7 +139,7 @@ func createHelperPod(ctx context.Context, experimentsDetails *experimentTypes.Ex
 						"run",
 					},
 					Args:      args,
-					Env:       envs,
+					Env:       envDetails.ENV,
 					Resources: chaosDetails.Resources,
 					VolumeMounts: []corev1.VolumeMount{
 						{
@@ -157,6 +162,8 @@ func createHelperPod(ctx context.Context, experimentsDetails *experimentTypes.Ex
 		},
 	}
 
+	common.MountOTelExporterSecret(helperPod)
+
 	_, err := clients.KubeClient.CoreV1().Pods(experimentsDetails.ChaosNamespace).Create(context.Background(), helperPod, v1.CreateOptions{})
 	if err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("unable to create helper pod: %s", err.Error())}
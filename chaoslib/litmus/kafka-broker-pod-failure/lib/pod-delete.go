@@ -107,7 +107,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 		//Deleting the application pod
 		for _, pod := range targetPodList.Items {
 
-			log.InfoWithValues("[Info]: Killing the following pods", logrus.Fields{
+			log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Info]: Killing the following pods", logrus.Fields{
 				"PodName": pod.Name})
 
 			if experimentsDetails.ChaoslibDetail.Force {
@@ -202,7 +202,7 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 		//Deleting the application pod
 		for _, pod := range targetPodList.Items {
 
-			log.InfoWithValues("[Info]: Killing the following pods", logrus.Fields{
+			log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Info]: Killing the following pods", logrus.Fields{
 				"PodName": pod.Name})
 
 			if experimentsDetails.ChaoslibDetail.Force {
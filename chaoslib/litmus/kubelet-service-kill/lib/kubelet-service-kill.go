@@ -29,12 +29,10 @@ func PrepareKubeletKill(ctx context.Context, experimentsDetails *experimentTypes
 	defer span.End()
 
 	var err error
-	if experimentsDetails.TargetNode == "" {
-		//Select node for kubelet-service-kill
-		experimentsDetails.TargetNode, err = common.GetNodeName(experimentsDetails.AppNS, experimentsDetails.AppLabel, experimentsDetails.NodeLabel, clients)
-		if err != nil {
-			return stacktrace.Propagate(err, "could not get node name")
-		}
+	//Select node for kubelet-service-kill
+	experimentsDetails.TargetNode, err = common.GetNodeName(experimentsDetails.TargetNode, experimentsDetails.AppNS, experimentsDetails.AppLabel, experimentsDetails.NodeLabel, clients, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not get node name")
 	}
 
 	log.InfoWithValues("[Info]: Details of node under chaos injection", logrus.Fields{
@@ -44,18 +42,18 @@ func PrepareKubeletKill(ctx context.Context, experimentsDetails *experimentTypes
 	experimentsDetails.RunID = stringutils.GetRunID()
 
 	//Waiting for the ramp time before chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time before injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
 
-	if experimentsDetails.EngineName != "" {
+	if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 		msg := "Injecting " + experimentsDetails.ExperimentName + " chaos on " + experimentsDetails.TargetNode + " node"
 		types.SetEngineEventAttributes(eventsDetails, types.ChaosInject, msg, "Normal", chaosDetails)
 		events.GenerateEvents(eventsDetails, clients, chaosDetails, "ChaosEngine")
 	}
 
-	if experimentsDetails.EngineName != "" {
+	if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 		if err := common.SetHelperData(chaosDetails, experimentsDetails.SetHelperData, clients); err != nil {
 			return stacktrace.Propagate(err, "could not set helper data")
 		}
@@ -74,12 +72,15 @@ func PrepareKubeletKill(ctx context.Context, experimentsDetails *experimentTypes
 	}
 
 	// Checking for the node to be in not-ready state
-	log.Info("[Status]: Check for the node to be in NotReady state")
-	if err = status.CheckNodeNotReadyState(experimentsDetails.TargetNode, experimentsDetails.Timeout, experimentsDetails.Delay, clients); err != nil {
-		if deleteErr := common.DeleteAllHelperPodBasedOnJobCleanupPolicy(appLabel, chaosDetails, clients); deleteErr != nil {
-			return cerrors.PreserveError{ErrString: fmt.Sprintf("[err: %v, delete error: %v]", err, deleteErr)}
+	// the kubelet is not stopped in dry-run mode
+	if !chaosDetails.DryRun {
+		log.Info("[Status]: Check for the node to be in NotReady state")
+		if err = status.CheckNodeNotReadyState(experimentsDetails.TargetNode, experimentsDetails.Timeout, experimentsDetails.Delay, clients); err != nil {
+			if deleteErr := common.DeleteAllHelperPodBasedOnJobCleanupPolicy(appLabel, chaosDetails, clients); deleteErr != nil {
+				return cerrors.PreserveError{ErrString: fmt.Sprintf("[err: %v, delete error: %v]", err, deleteErr)}
+			}
+			return stacktrace.Propagate(err, "could not check for NOT READY state")
 		}
-		return stacktrace.Propagate(err, "could not check for NOT READY state")
 	}
 
 	if err := common.WaitForCompletionAndDeleteHelperPods(appLabel, chaosDetails, clients, false); err != nil {
@@ -87,7 +88,7 @@ func PrepareKubeletKill(ctx context.Context, experimentsDetails *experimentTypes
 	}
 
 	//Waiting for the ramp time after chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time after injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
@@ -184,7 +185,7 @@ func createHelperPod(ctx context.Context, experimentsDetails *experimentTypes.Ex
 		helperPod.Spec.Volumes = append(helperPod.Spec.Volumes, common.GetSidecarVolumes(chaosDetails)...)
 	}
 
-	if err := clients.CreatePod(experimentsDetails.ChaosNamespace, helperPod); err != nil {
+	if err := common.CreateHelperPod(experimentsDetails.ChaosNamespace, helperPod, chaosDetails, clients); err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("unable to create helper pod: %s", err.Error())}
 	}
 
@@ -13,6 +13,8 @@ import (
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
 	"github.com/litmuschaos/litmus-go/pkg/events"
+	"github.com/litmuschaos/litmus-go/pkg/journal"
+	"github.com/litmuschaos/litmus-go/pkg/registry"
 	"github.com/litmuschaos/litmus-go/pkg/telemetry"
 	"github.com/palantir/stacktrace"
 	"go.opentelemetry.io/otel"
@@ -26,6 +28,14 @@ import (
 	clientTypes "k8s.io/apimachinery/pkg/types"
 )
 
+func init() {
+	registry.RegisterHelper("network-chaos", "Injects the network chaos inside the target containers", Helper)
+	journal.RegisterReverter(fault, revertJournalEntry)
+}
+
+// fault is the name of the network chaos inside the revert journal
+const fault = "network-chaos"
+
 const (
 	qdiscNotFound    = "Cannot delete qdisc with handle of zero"
 	qdiscNoFileFound = "RTNETLINK answers: No such file or directory"
@@ -34,6 +44,7 @@ const (
 var (
 	err                                              error
 	inject, abort                                    chan os.Signal
+	revertJournal                                    *journal.Journal
 	sPorts, dPorts, whitelistDPorts, whitelistSPorts []string
 )
 
@@ -62,7 +73,9 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 	getENV(&experimentsDetails)
 
 	// Initialise the chaos attributes
-	types.InitialiseChaosVariables(&chaosDetails)
+	if err := types.InitialiseChaosVariables(&chaosDetails); err != nil {
+		log.Fatalf("helper pod failed, err: %v", err)
+	}
 	chaosDetails.Phase = types.ChaosInjectPhase
 
 	// Initialise Chaos Result Parameters
@@ -71,7 +84,7 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 	// Set the chaos result uid
 	result.SetResultUID(&resultDetails, clients, &chaosDetails)
 
-	err := preparePodNetworkChaos(&experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails)
+	err := preparePodNetworkChaos(ctx, &experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails)
 	if err != nil {
 		// update failstep inside chaosresult
 		if resultErr := result.UpdateFailedStepFromHelper(&resultDetails, &chaosDetails, clients, err); resultErr != nil {
@@ -83,7 +96,7 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 }
 
 // preparePodNetworkChaos contains the prepration steps before chaos injection
-func preparePodNetworkChaos(experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
+func preparePodNetworkChaos(ctx context.Context, experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
 	targetEnv := os.Getenv("TARGETS")
 	if targetEnv == "" {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeHelper, Source: chaosDetails.ChaosPodName, Reason: "no target found, provide atleast one target"}
@@ -118,8 +131,16 @@ func preparePodNetworkChaos(experimentsDetails *experimentTypes.ExperimentDetail
 		targets = append(targets, td)
 	}
 
+	// the revert journal keeps track of the injected faults, so that they are reverted even if the helper gets killed
+	if revertJournal, err = journal.New(clients, chaosDetails.ChaosNamespace, chaosDetails.ChaosPodName); err != nil {
+		log.Warnf("Unable to open the revert journal, err: %v", err)
+	}
+	if _, err := revertJournal.Reconcile(fault); err != nil {
+		log.Warnf("Unable to revert the leftover faults, err: %v", err)
+	}
+
 	// watching for the abort signal and revert the chaos
-	go abortWatcher(targets, experimentsDetails.NetworkInterface, resultDetails.Name, chaosDetails.ChaosNamespace)
+	go abortWatcher(ctx, targets, experimentsDetails.NetworkInterface, resultDetails.Name, chaosDetails.ChaosNamespace)
 
 	select {
 	case <-inject:
@@ -129,16 +150,19 @@ func preparePodNetworkChaos(experimentsDetails *experimentTypes.ExperimentDetail
 	}
 
 	for index, t := range targets {
+		if err := revertJournal.Record(journalEntry(t, experimentsDetails, resultDetails.Name)); err != nil {
+			log.Warnf("Unable to record the chaos inside the revert journal, err: %v", err)
+		}
 		// injecting network chaos inside target container
 		if err = injectChaos(experimentsDetails.NetworkInterface, t); err != nil {
-			if revertErr := revertChaosForAllTargets(targets, experimentsDetails.NetworkInterface, resultDetails, chaosDetails.ChaosNamespace, index-1); revertErr != nil {
+			if revertErr := revertChaosForAllTargets(ctx, targets, experimentsDetails.NetworkInterface, resultDetails, chaosDetails.ChaosNamespace, index-1); revertErr != nil {
 				return cerrors.PreserveError{ErrString: fmt.Sprintf("[%s,%s]", stacktrace.RootCause(err).Error(), stacktrace.RootCause(revertErr).Error())}
 			}
 			return stacktrace.Propagate(err, "could not inject chaos")
 		}
-		log.Infof("successfully injected chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer)
+		log.InfoWithTarget(ctx, "pod/"+t.Name, fmt.Sprintf("successfully injected chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer), nil)
 		if err = result.AnnotateChaosResult(resultDetails.Name, chaosDetails.ChaosNamespace, "injected", "pod", t.Name); err != nil {
-			if revertErr := revertChaosForAllTargets(targets, experimentsDetails.NetworkInterface, resultDetails, chaosDetails.ChaosNamespace, index); revertErr != nil {
+			if revertErr := revertChaosForAllTargets(ctx, targets, experimentsDetails.NetworkInterface, resultDetails, chaosDetails.ChaosNamespace, index); revertErr != nil {
 				return cerrors.PreserveError{ErrString: fmt.Sprintf("[%s,%s]", stacktrace.RootCause(err).Error(), stacktrace.RootCause(revertErr).Error())}
 			}
 			return stacktrace.Propagate(err, "could not annotate chaosresult")
@@ -157,21 +181,22 @@ func preparePodNetworkChaos(experimentsDetails *experimentTypes.ExperimentDetail
 
 	log.Info("[Chaos]: Duration is over, reverting chaos")
 
-	if err := revertChaosForAllTargets(targets, experimentsDetails.NetworkInterface, resultDetails, chaosDetails.ChaosNamespace, len(targets)-1); err != nil {
+	if err := revertChaosForAllTargets(ctx, targets, experimentsDetails.NetworkInterface, resultDetails, chaosDetails.ChaosNamespace, len(targets)-1); err != nil {
 		return stacktrace.Propagate(err, "could not revert chaos")
 	}
 
 	return nil
 }
 
-func revertChaosForAllTargets(targets []targetDetails, networkInterface string, resultDetails *types.ResultDetails, chaosNs string, index int) error {
+func revertChaosForAllTargets(ctx context.Context, targets []targetDetails, networkInterface string, resultDetails *types.ResultDetails, chaosNs string, index int) error {
 	var errList []string
 	for i := 0; i <= index; i++ {
-		killed, err := killnetem(targets[i], networkInterface)
+		killed, err := killnetem(ctx, targets[i], networkInterface)
 		if !killed && err != nil {
 			errList = append(errList, err.Error())
 			continue
 		}
+		removeJournalEntry(targets[i])
 		if killed && err == nil {
 			if err = result.AnnotateChaosResult(resultDetails.Name, chaosNs, "reverted", "pod", targets[i].Name); err != nil {
 				errList = append(errList, err.Error())
@@ -193,7 +218,8 @@ func injectChaos(netInterface string, target targetDetails) error {
 	netemCommands := os.Getenv("NETEM_COMMAND")
 
 	if len(target.DestinationIps) == 0 && len(sPorts) == 0 && len(dPorts) == 0 && len(whitelistDPorts) == 0 && len(whitelistSPorts) == 0 {
-		tc := fmt.Sprintf("sudo nsenter --net=%s tc qdisc replace dev %s root %v", target.NetworkNsPath, netInterface, netemCommands)
+		// the netem qdisc is marked by its handle, so that the revert helper deletes only the qdiscs of the helpers
+		tc := fmt.Sprintf("sudo nsenter --net=%s tc qdisc replace dev %s root handle %s %v", target.NetworkNsPath, netInterface, common.NetemHandle, netemCommands)
 		log.Info(tc)
 		if err := common.RunBashCommand(tc, "failed to create tc rules", target.Source); err != nil {
 			return err
@@ -209,7 +235,7 @@ func injectChaos(netInterface string, target targetDetails) error {
 
 		// Add queueing discipline for 1:3 class.
 		// No traffic is going through 1:3 yet
-		traffic := fmt.Sprintf("sudo nsenter --net=%s tc qdisc replace dev %v parent 1:3 %v", target.NetworkNsPath, netInterface, netemCommands)
+		traffic := fmt.Sprintf("sudo nsenter --net=%s tc qdisc replace dev %v parent 1:3 handle %s %v", target.NetworkNsPath, netInterface, common.NetemHandle, netemCommands)
 		log.Info(traffic)
 		if err := common.RunBashCommand(traffic, "failed to create netem queueing discipline", target.Source); err != nil {
 			return err
@@ -307,7 +333,7 @@ func injectChaos(netInterface string, target targetDetails) error {
 }
 
 // killnetem kill the netem process for all the target containers
-func killnetem(target targetDetails, networkInterface string) (bool, error) {
+func killnetem(ctx context.Context, target targetDetails, networkInterface string) (bool, error) {
 	tc := fmt.Sprintf("sudo nsenter --net=%s tc qdisc delete dev %s root", target.NetworkNsPath, networkInterface)
 	cmd := exec.Command("/bin/bash", "-c", tc)
 	out, err := cmd.CombinedOutput()
@@ -322,7 +348,7 @@ func killnetem(target targetDetails, networkInterface string) (bool, error) {
 		log.Error(err.Error())
 		return false, cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Source: target.Source, Target: fmt.Sprintf("{podName: %s, namespace: %s, container: %s}", target.Name, target.Namespace, target.TargetContainer), Reason: fmt.Sprintf("failed to revert network faults: %s", string(out))}
 	}
-	log.Infof("successfully reverted chaos on target: {name: %s, namespace: %v, container: %v}", target.Name, target.Namespace, target.TargetContainer)
+	log.InfoWithTarget(ctx, "pod/"+target.Name, fmt.Sprintf("successfully reverted chaos on target: {name: %s, namespace: %v, container: %v}", target.Name, target.Namespace, target.TargetContainer), nil)
 	return true, nil
 }
 
@@ -370,7 +396,7 @@ func getENV(experimentDetails *experimentTypes.ExperimentDetails) {
 }
 
 // abortWatcher continuously watch for the abort signals
-func abortWatcher(targets []targetDetails, networkInterface, resultName, chaosNS string) {
+func abortWatcher(ctx context.Context, targets []targetDetails, networkInterface, resultName, chaosNS string) {
 
 	<-abort
 	log.Info("[Chaos]: Killing process started because of terminated signal received")
@@ -379,11 +405,12 @@ func abortWatcher(targets []targetDetails, networkInterface, resultName, chaosNS
 	retry := 3
 	for retry > 0 {
 		for _, t := range targets {
-			killed, err := killnetem(t, networkInterface)
+			killed, err := killnetem(ctx, t, networkInterface)
 			if err != nil && !killed {
 				log.Errorf("unable to kill netem process, err :%v", err)
 				continue
 			}
+			removeJournalEntry(t)
 			if killed && err == nil {
 				if err = result.AnnotateChaosResult(resultName, chaosNS, "reverted", "pod", t.Name); err != nil {
 					log.Errorf("unable to annotate the chaosresult, err :%v", err)
@@ -396,6 +423,52 @@ func abortWatcher(targets []targetDetails, networkInterface, resultName, chaosNS
 	log.Info("Chaos Revert Completed")
 	os.Exit(1)
 }
+
+// journalEntry returns the revert journal entry of the given target
+func journalEntry(target targetDetails, experimentsDetails *experimentTypes.ExperimentDetails, resultName string) journal.Entry {
+	return journal.Entry{
+		Fault:     fault,
+		Pod:       target.Name,
+		Namespace: target.Namespace,
+		Container: target.TargetContainer,
+		Result:    resultName,
+		Details: map[string]string{
+			"containerRuntime": experimentsDetails.ContainerRuntime,
+			"socketPath":       experimentsDetails.SocketPath,
+			"containerID":      target.ContainerId,
+			"networkInterface": experimentsDetails.NetworkInterface,
+		},
+	}
+}
+
+// removeJournalEntry removes the entry of the given target from the revert journal, once the chaos is reverted
+func removeJournalEntry(target targetDetails) {
+	entry := journal.Entry{Fault: fault, Pod: target.Name, Namespace: target.Namespace, Container: target.TargetContainer}
+	if err := revertJournal.Remove(entry); err != nil {
+		log.Warnf("Unable to remove the chaos from the revert journal, err: %v", err)
+	}
+}
+
+// revertJournalEntry reverts the leftover network chaos of the given journal entry
+// the network ns path is derived again, as it belongs to the pod sandbox of the target
+func revertJournalEntry(entry journal.Entry) error {
+	target := targetDetails{
+		Name:            entry.Pod,
+		Namespace:       entry.Namespace,
+		TargetContainer: entry.Container,
+		ContainerId:     entry.Details["containerID"],
+		Source:          entry.HelperPod,
+	}
+	target.NetworkNsPath, err = common.GetNetworkNsPath(entry.Details["containerRuntime"], target.ContainerId, entry.Details["socketPath"], target.Source)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not get container network ns path")
+	}
+	if killed, err := killnetem(context.Background(), target, entry.Details["networkInterface"]); !killed {
+		return err
+	}
+	return nil
+}
+
 func getDestIps(serviceMesh string) []string {
 	var (
 		destIps   = os.Getenv("DESTINATION_IPS")
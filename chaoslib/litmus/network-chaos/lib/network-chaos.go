@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"os"
 	"strconv"
 	"strings"
 
@@ -49,7 +48,7 @@ func PrepareAndInjectChaos(ctx context.Context, experimentsDetails *experimentTy
 	}
 
 	//Waiting for the ramp time before chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time before injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
@@ -62,7 +61,7 @@ func PrepareAndInjectChaos(ctx context.Context, experimentsDetails *experimentTy
 		}
 	}
 
-	if experimentsDetails.EngineName != "" {
+	if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 		if err := common.SetHelperData(chaosDetails, experimentsDetails.SetHelperData, clients); err != nil {
 			return stacktrace.Propagate(err, "could not set helper data")
 		}
@@ -260,7 +259,9 @@ func createHelperPod(ctx context.Context, experimentsDetails *experimentTypes.Ex
 		})
 	}
 
-	if err := clients.CreatePod(experimentsDetails.ChaosNamespace, helperPod); err != nil {
+	common.MountOTelExporterSecret(helperPod)
+
+	if err := common.CreateHelperPod(experimentsDetails.ChaosNamespace, helperPod, chaosDetails, clients); err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("unable to create helper pod: %s", err.Error())}
 	}
 
@@ -286,7 +287,8 @@ func getPodEnv(ctx context.Context, experimentsDetails *experimentTypes.Experime
 		SetEnv("DESTINATION_IPS_SERVICE_MESH", destIpsSvcMesh).
 		SetEnv("SOURCE_PORTS", experimentsDetails.SourcePorts).
 		SetEnv("DESTINATION_PORTS", experimentsDetails.DestinationPorts).
-		SetEnv("OTEL_EXPORTER_OTLP_ENDPOINT", os.Getenv(telemetry.OTELExporterOTLPEndpoint)).
+		SetOTelExporterEnv().
+		SetLogFormatEnv().
 		SetEnv("TRACE_PARENT", telemetry.GetMarshalledSpanFromContext(ctx)).
 		SetEnvFromDownwardAPI("v1", "metadata.name")
 
@@ -547,7 +549,6 @@ func logExperimentFields(experimentsDetails *experimentTypes.ExperimentDetails)
 			"NetworkBandwidth": experimentsDetails.NetworkBandwidth,
 			"Sequence":         experimentsDetails.Sequence,
 			"PodsAffectedPerc": experimentsDetails.PodsAffectedPerc,
-			"Correlation":      experimentsDetails.Correlation,
 		})
 	}
 }
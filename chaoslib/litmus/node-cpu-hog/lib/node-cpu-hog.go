@@ -16,7 +16,6 @@ import (
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/generic/node-cpu-hog/types"
 	"github.com/litmuschaos/litmus-go/pkg/log"
 	"github.com/litmuschaos/litmus-go/pkg/probe"
-	"github.com/litmuschaos/litmus-go/pkg/status"
 	"github.com/litmuschaos/litmus-go/pkg/types"
 	"github.com/litmuschaos/litmus-go/pkg/utils/common"
 	"github.com/litmuschaos/litmus-go/pkg/utils/stringutils"
@@ -41,14 +40,14 @@ func PrepareNodeCPUHog(ctx context.Context, experimentsDetails *experimentTypes.
 	})
 
 	//Waiting for the ramp time before chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time before injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
 
 	//Select node for node-cpu-hog
 	nodesAffectedPerc, _ := strconv.Atoi(experimentsDetails.NodesAffectedPerc)
-	targetNodeList, err := common.GetNodeList(experimentsDetails.TargetNodes, experimentsDetails.NodeLabel, nodesAffectedPerc, clients)
+	targetNodeList, err := common.GetNodeList(experimentsDetails.TargetNodes, experimentsDetails.NodeLabel, nodesAffectedPerc, clients, chaosDetails)
 	if err != nil {
 		return stacktrace.Propagate(err, "could not get node list")
 	}
@@ -58,7 +57,7 @@ func PrepareNodeCPUHog(ctx context.Context, experimentsDetails *experimentTypes.
 		"Node Names":   targetNodeList,
 	})
 
-	if experimentsDetails.EngineName != "" {
+	if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 		if err := common.SetHelperData(chaosDetails, experimentsDetails.SetHelperData, clients); err != nil {
 			return stacktrace.Propagate(err, "could not set helper data")
 		}
@@ -78,7 +77,7 @@ func PrepareNodeCPUHog(ctx context.Context, experimentsDetails *experimentTypes.
 	}
 
 	//Waiting for the ramp time after chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time after injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
@@ -101,7 +100,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 
 	for _, appNode := range targetNodeList {
 
-		if experimentsDetails.EngineName != "" {
+		if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 			msg := "Injecting " + experimentsDetails.ExperimentName + " chaos on " + appNode + " node"
 			types.SetEngineEventAttributes(eventsDetails, types.ChaosInject, msg, "Normal", chaosDetails)
 			events.GenerateEvents(eventsDetails, clients, chaosDetails, "ChaosEngine")
@@ -114,7 +113,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 			}
 		}
 
-		log.InfoWithValues("[Info]: Details of Node under chaos injection", logrus.Fields{
+		log.InfoWithTarget(ctx, "node/"+appNode, "[Info]: Details of Node under chaos injection", logrus.Fields{
 			"NodeName":     appNode,
 			"NodeCPUCores": experimentsDetails.NodeCPUcores,
 		})
@@ -128,27 +127,10 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 
 		appLabel := fmt.Sprintf("app=%s-helper-%s", experimentsDetails.ExperimentName, experimentsDetails.RunID)
 
-		//Checking the status of helper pod
-		log.Info("[Status]: Checking the status of the helper pod")
-		if err := status.CheckHelperStatus(experimentsDetails.ChaosNamespace, appLabel, experimentsDetails.Timeout, experimentsDetails.Delay, clients); err != nil {
-			common.DeleteAllHelperPodBasedOnJobCleanupPolicy(appLabel, chaosDetails, clients)
-			return stacktrace.Propagate(err, "could not check helper status")
-		}
-
 		common.SetTargets(appNode, "targeted", "node", chaosDetails)
 
-		// Wait till the completion of helper pod
-		log.Info("[Wait]: Waiting till the completion of the helper pod")
-		podStatus, err := status.WaitForCompletion(experimentsDetails.ChaosNamespace, appLabel, clients, experimentsDetails.ChaosDuration+experimentsDetails.Timeout, experimentsDetails.ExperimentName)
-		if err != nil || podStatus == "Failed" {
-			common.DeleteAllHelperPodBasedOnJobCleanupPolicy(appLabel, chaosDetails, clients)
-			return common.HelperFailedError(err, appLabel, chaosDetails.ChaosNamespace, false)
-		}
-
-		//Deleting the helper pod
-		log.Info("[Cleanup]: Deleting the helper pod")
-		if err := common.DeleteAllPod(appLabel, experimentsDetails.ChaosNamespace, chaosDetails.Timeout, chaosDetails.Delay, clients); err != nil {
-			return stacktrace.Propagate(err, "could not delete helper pod(s)")
+		if err := common.ManagerHelperLifecycle(appLabel, chaosDetails, clients, false); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -172,7 +154,7 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 
 	for _, appNode := range targetNodeList {
 
-		if experimentsDetails.EngineName != "" {
+		if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 			msg := "Injecting " + experimentsDetails.ExperimentName + " chaos on " + appNode + " node"
 			types.SetEngineEventAttributes(eventsDetails, types.ChaosInject, msg, "Normal", chaosDetails)
 			events.GenerateEvents(eventsDetails, clients, chaosDetails, "ChaosEngine")
@@ -185,7 +167,7 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 			}
 		}
 
-		log.InfoWithValues("[Info]: Details of Node under chaos injection", logrus.Fields{
+		log.InfoWithTarget(ctx, "node/"+appNode, "[Info]: Details of Node under chaos injection", logrus.Fields{
 			"NodeName":     appNode,
 			"NodeCPUcores": experimentsDetails.NodeCPUcores,
 		})
@@ -261,7 +243,7 @@ func createHelperPod(ctx context.Context, experimentsDetails *experimentTypes.Ex
 		helperPod.Spec.Volumes = append(helperPod.Spec.Volumes, common.GetSidecarVolumes(chaosDetails)...)
 	}
 
-	if err := clients.CreatePod(experimentsDetails.ChaosNamespace, helperPod); err != nil {
+	if err := common.CreateHelperPod(experimentsDetails.ChaosNamespace, helperPod, chaosDetails, clients); err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("unable to create helper pod: %s", err.Error())}
 	}
 
@@ -5,9 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
-	"os/signal"
 	"strconv"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -42,12 +40,12 @@ func PrepareNodeDrain(ctx context.Context, experimentsDetails *experimentTypes.E
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -55,12 +53,10 @@ func PrepareNodeDrain(ctx context.Context, experimentsDetails *experimentTypes.E
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
 
-	if experimentsDetails.TargetNode == "" {
-		//Select node for kubelet-service-kill
-		experimentsDetails.TargetNode, err = common.GetNodeName(experimentsDetails.AppNS, experimentsDetails.AppLabel, experimentsDetails.NodeLabel, clients)
-		if err != nil {
-			return stacktrace.Propagate(err, "could not get node name")
-		}
+	//Select node for node-drain
+	experimentsDetails.TargetNode, err = common.GetNodeName(experimentsDetails.TargetNode, experimentsDetails.AppNS, experimentsDetails.AppLabel, experimentsDetails.NodeLabel, clients, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not get node name")
 	}
 
 	if experimentsDetails.EngineName != "" {
@@ -41,14 +41,14 @@ func PrepareNodeMemoryHog(ctx context.Context, experimentsDetails *experimentTyp
 	})
 
 	//Waiting for the ramp time before chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time before injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
 
 	//Select node for node-memory-hog
 	nodesAffectedPerc, _ := strconv.Atoi(experimentsDetails.NodesAffectedPerc)
-	targetNodeList, err := common.GetNodeList(experimentsDetails.TargetNodes, experimentsDetails.NodeLabel, nodesAffectedPerc, clients)
+	targetNodeList, err := common.GetNodeList(experimentsDetails.TargetNodes, experimentsDetails.NodeLabel, nodesAffectedPerc, clients, chaosDetails)
 	if err != nil {
 		return stacktrace.Propagate(err, "could not get node list")
 	}
@@ -58,7 +58,7 @@ func PrepareNodeMemoryHog(ctx context.Context, experimentsDetails *experimentTyp
 		"Node Names":   targetNodeList,
 	})
 
-	if experimentsDetails.EngineName != "" {
+	if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 		if err := common.SetHelperData(chaosDetails, experimentsDetails.SetHelperData, clients); err != nil {
 			return stacktrace.Propagate(err, "could not set helper data")
 		}
@@ -78,7 +78,7 @@ func PrepareNodeMemoryHog(ctx context.Context, experimentsDetails *experimentTyp
 	}
 
 	//Waiting for the ramp time after chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time after injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
@@ -99,13 +99,13 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 
 	for _, appNode := range targetNodeList {
 
-		if experimentsDetails.EngineName != "" {
+		if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 			msg := "Injecting " + experimentsDetails.ExperimentName + " chaos on " + appNode + " node"
 			types.SetEngineEventAttributes(eventsDetails, types.ChaosInject, msg, "Normal", chaosDetails)
 			events.GenerateEvents(eventsDetails, clients, chaosDetails, "ChaosEngine")
 		}
 
-		log.InfoWithValues("[Info]: Details of Node under chaos injection", logrus.Fields{
+		log.InfoWithTarget(ctx, "node/"+appNode, "[Info]: Details of Node under chaos injection", logrus.Fields{
 			"NodeName":                      appNode,
 			"Memory Consumption Percentage": experimentsDetails.MemoryConsumptionPercentage,
 			"Memory Consumption Mebibytes":  experimentsDetails.MemoryConsumptionMebibytes,
@@ -157,13 +157,13 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 
 	for _, appNode := range targetNodeList {
 
-		if experimentsDetails.EngineName != "" {
+		if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 			msg := "Injecting " + experimentsDetails.ExperimentName + " chaos on " + appNode + " node"
 			types.SetEngineEventAttributes(eventsDetails, types.ChaosInject, msg, "Normal", chaosDetails)
 			events.GenerateEvents(eventsDetails, clients, chaosDetails, "ChaosEngine")
 		}
 
-		log.InfoWithValues("[Info]: Details of Node under chaos injection", logrus.Fields{
+		log.InfoWithTarget(ctx, "node/"+appNode, "[Info]: Details of Node under chaos injection", logrus.Fields{
 			"NodeName":                      appNode,
 			"Memory Consumption Percentage": experimentsDetails.MemoryConsumptionPercentage,
 			"Memory Consumption Mebibytes":  experimentsDetails.MemoryConsumptionMebibytes,
@@ -325,7 +325,7 @@ func createHelperPod(ctx context.Context, experimentsDetails *experimentTypes.Ex
 		helperPod.Spec.Volumes = append(helperPod.Spec.Volumes, common.GetSidecarVolumes(chaosDetails)...)
 	}
 
-	if err := clients.CreatePod(experimentsDetails.ChaosNamespace, helperPod); err != nil {
+	if err := common.CreateHelperPod(experimentsDetails.ChaosNamespace, helperPod, chaosDetails, clients); err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("unable to create helper pod: %s", err.Error())}
 	}
 
@@ -30,7 +30,7 @@ func PreparePodDelete(ctx context.Context, experimentsDetails *experimentTypes.E
 	defer span.End()
 
 	//Waiting for the ramp time before chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time before injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
@@ -43,6 +43,10 @@ func PreparePodDelete(ctx context.Context, experimentsDetails *experimentTypes.E
 		"Sequence":         experimentsDetails.Sequence,
 	})
 
+	if chaosDetails.DryRun {
+		return planPodDelete(experimentsDetails, clients, chaosDetails)
+	}
+
 	switch strings.ToLower(experimentsDetails.Sequence) {
 	case "serial":
 		if err := injectChaosInSerialMode(ctx, experimentsDetails, clients, chaosDetails, eventsDetails, resultDetails); err != nil {
@@ -57,13 +61,39 @@ func PreparePodDelete(ctx context.Context, experimentsDetails *experimentTypes.E
 	}
 
 	//Waiting for the ramp time after chaos injection
-	if experimentsDetails.RampTime != 0 {
+	if experimentsDetails.RampTime != 0 && !chaosDetails.DryRun {
 		log.Infof("[Ramp]: Waiting for the %vs ramp time after injecting chaos", experimentsDetails.RampTime)
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
 	return nil
 }
 
+// planPodDelete records the deletion of the target pods inside the plan, without deleting them
+func planPodDelete(experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
+	if experimentsDetails.TargetPods == "" && chaosDetails.AppDetail == nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "provide one of the appLabel or TARGET_PODS"}
+	}
+
+	targetPodList, err := common.GetTargetPods(experimentsDetails.NodeLabel, experimentsDetails.TargetPods, experimentsDetails.PodsAffectedPerc, experimentsDetails.PodTerminationOrder, clients, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not get target pods")
+	}
+
+	for _, pod := range targetPodList.Items {
+		kind, parentName, err := workloads.GetPodOwnerTypeAndName(&pod, clients.DynamicClient)
+		if err != nil {
+			return stacktrace.Propagate(err, "could not get pod owner name and kind")
+		}
+		common.RecordPlanStep("delete pod", pod.Name, map[string]string{
+			"namespace": pod.Namespace,
+			"owner":     fmt.Sprintf("%s/%s", kind, parentName),
+			"force":     strconv.FormatBool(experimentsDetails.Force),
+			"sequence":  experimentsDetails.Sequence,
+		}, chaosDetails)
+	}
+	return nil
+}
+
 // injectChaosInSerialMode delete the target application pods serial mode(one by one)
 func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails, eventsDetails *types.EventDetails, resultDetails *types.ResultDetails) error {
 	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "InjectPodDeleteFaultInSerialMode")
@@ -105,7 +135,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 			common.SetTargets(target.Name, "targeted", target.Kind, chaosDetails)
 		}
 
-		if experimentsDetails.EngineName != "" {
+		if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 			msg := "Injecting " + experimentsDetails.ExperimentName + " chaos on application pod"
 			types.SetEngineEventAttributes(eventsDetails, types.ChaosInject, msg, "Normal", chaosDetails)
 			if err := events.GenerateEvents(eventsDetails, clients, chaosDetails, "ChaosEngine"); err != nil {
@@ -116,7 +146,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 		//Deleting the application pod
 		for i, pod := range targetPodList.Items {
 
-			log.InfoWithValues("Killing the following pods", logrus.Fields{
+			log.InfoWithTarget(ctx, "pod/"+pod.Name, "Killing the following pods", logrus.Fields{
 				"PodName": pod.Name})
 
 			if experimentsDetails.Force {
@@ -211,7 +241,7 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 			common.SetTargets(target.Name, "targeted", target.Kind, chaosDetails)
 		}
 
-		if experimentsDetails.EngineName != "" {
+		if experimentsDetails.EngineName != "" && !chaosDetails.DryRun {
 			msg := "Injecting " + experimentsDetails.ExperimentName + " chaos on application pod"
 			types.SetEngineEventAttributes(eventsDetails, types.ChaosInject, msg, "Normal", chaosDetails)
 			if err := events.GenerateEvents(eventsDetails, clients, chaosDetails, "ChaosEngine"); err != nil {
@@ -223,7 +253,7 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 		// as the intention is to terminate all targeted pods simultaneously.
 		for _, pod := range targetPodList.Items {
 
-			log.InfoWithValues("Killing the following pods", logrus.Fields{
+			log.InfoWithTarget(ctx, "pod/"+pod.Name, "Killing the following pods", logrus.Fields{
 				"PodName": pod.Name})
 
 			if experimentsDetails.Force {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/registry"
 	"github.com/litmuschaos/litmus-go/pkg/telemetry"
 	"github.com/palantir/stacktrace"
 	"go.opentelemetry.io/otel"
@@ -26,6 +27,10 @@ import (
 	clientTypes "k8s.io/apimachinery/pkg/types"
 )
 
+func init() {
+	registry.RegisterHelper("dns-chaos", "Injects the dns chaos inside the target containers", Helper)
+}
+
 var (
 	abort, injectAbort chan os.Signal
 	err                error
@@ -61,7 +66,9 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 	getENV(&experimentsDetails)
 
 	// Initialise the chaos attributes
-	types.InitialiseChaosVariables(&chaosDetails)
+	if err := types.InitialiseChaosVariables(&chaosDetails); err != nil {
+		log.Fatalf("helper pod failed, err: %v", err)
+	}
 
 	// Initialise Chaos Result Parameters
 	types.SetResultAttributes(&resultDetails, chaosDetails)
@@ -69,7 +76,7 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 	// Set the chaos result uid
 	result.SetResultUID(&resultDetails, clients, &chaosDetails)
 
-	if err := preparePodDNSChaos(&experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails); err != nil {
+	if err := preparePodDNSChaos(ctx, &experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails); err != nil {
 		// update failstep inside chaosresult
 		if resultErr := result.UpdateFailedStepFromHelper(&resultDetails, &chaosDetails, clients, err); resultErr != nil {
 			log.Fatalf("helper pod failed, err: %v, resultErr: %v", err, resultErr)
@@ -80,7 +87,7 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 }
 
 // preparePodDNSChaos contains the preparation steps before chaos injection
-func preparePodDNSChaos(experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
+func preparePodDNSChaos(ctx context.Context, experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
 
 	targetList, err := common.ParseTargets(chaosDetails.ChaosPodName)
 	if err != nil {
@@ -111,7 +118,7 @@ func preparePodDNSChaos(experimentsDetails *experimentTypes.ExperimentDetails, c
 	}
 
 	// watching for the abort signal and revert the chaos if an abort signal is received
-	go abortWatcher(targets, resultDetails.Name, chaosDetails.ChaosNamespace)
+	go abortWatcher(ctx, targets, resultDetails.Name, chaosDetails.ChaosNamespace)
 
 	select {
 	case <-injectAbort:
@@ -127,9 +134,9 @@ func preparePodDNSChaos(experimentsDetails *experimentTypes.ExperimentDetails, c
 		if err != nil {
 			return stacktrace.Propagate(err, "could not inject chaos")
 		}
-		log.Infof("successfully injected chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer)
+		log.InfoWithTarget(ctx, "pod/"+t.Name, fmt.Sprintf("successfully injected chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer), nil)
 		if err = result.AnnotateChaosResult(resultDetails.Name, chaosDetails.ChaosNamespace, "injected", "pod", t.Name); err != nil {
-			if revertErr := terminateProcess(t); revertErr != nil {
+			if revertErr := terminateProcess(ctx, t); revertErr != nil {
 				return cerrors.PreserveError{ErrString: fmt.Sprintf("[%s,%s]", stacktrace.RootCause(err).Error(), stacktrace.RootCause(revertErr).Error())}
 			}
 			return stacktrace.Propagate(err, "could not annotate chaosresult")
@@ -170,7 +177,7 @@ func preparePodDNSChaos(experimentsDetails *experimentTypes.ExperimentDetails, c
 		log.Info("[Timeout]: Killing the stress process")
 		var errList []string
 		for _, t := range targets {
-			if err = terminateProcess(t); err != nil {
+			if err = terminateProcess(ctx, t); err != nil {
 				errList = append(errList, err.Error())
 				continue
 			}
@@ -190,7 +197,7 @@ func preparePodDNSChaos(experimentsDetails *experimentTypes.ExperimentDetails, c
 			log.Info("[Info]: Reverting Chaos")
 			var errList []string
 			for _, t := range targets {
-				if err := terminateProcess(t); err != nil {
+				if err := terminateProcess(ctx, t); err != nil {
 					errList = append(errList, err.Error())
 					continue
 				}
@@ -212,7 +219,8 @@ func injectChaos(experimentsDetails *experimentTypes.ExperimentDetails, t target
 
 	// prepare dns interceptor
 	var out bytes.Buffer
-	commandTemplate := fmt.Sprintf("sudo TARGET_PID=%d CHAOS_TYPE=%s SPOOF_MAP='%s' TARGET_HOSTNAMES='%s' CHAOS_DURATION=%d MATCH_SCHEME=%s nsutil -p -n -t %d -- dns_interceptor", t.Pid, experimentsDetails.ChaosType, experimentsDetails.SpoofMap, experimentsDetails.TargetHostNames, experimentsDetails.ChaosDuration, experimentsDetails.MatchScheme, t.Pid)
+	// the interceptor is marked by the ENV, so that the revert helper kills only the interceptors of the helpers
+	commandTemplate := fmt.Sprintf("sudo %s=%s TARGET_PID=%d CHAOS_TYPE=%s SPOOF_MAP='%s' TARGET_HOSTNAMES='%s' CHAOS_DURATION=%d MATCH_SCHEME=%s nsutil -p -n -t %d -- dns_interceptor", common.ChaosMarkEnv, common.ChaosMark(string(experimentsDetails.ChaosUID)), t.Pid, experimentsDetails.ChaosType, experimentsDetails.SpoofMap, experimentsDetails.TargetHostNames, experimentsDetails.ChaosDuration, experimentsDetails.MatchScheme, t.Pid)
 	cmd := exec.Command("/bin/bash", "-c", commandTemplate)
 	log.Info(cmd.String())
 	cmd.Stdout = &out
@@ -224,7 +232,7 @@ func injectChaos(experimentsDetails *experimentTypes.ExperimentDetails, t target
 	return cmd, nil
 }
 
-func terminateProcess(t targetDetails) error {
+func terminateProcess(ctx context.Context, t targetDetails) error {
 	// kill command
 	killTemplate := fmt.Sprintf("sudo kill %d", t.Cmd.Process.Pid)
 	kill := exec.Command("/bin/bash", "-c", killTemplate)
@@ -238,13 +246,13 @@ func terminateProcess(t targetDetails) error {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Source: t.Source, Target: fmt.Sprintf("{podName: %s, namespace: %s}", t.Name, t.Namespace), Reason: fmt.Sprintf("failed to revert chaos %s", out.String())}
 	} else {
 		log.Errorf("dns interceptor process stopped")
-		log.Infof("successfully injected chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer)
+		log.InfoWithTarget(ctx, "pod/"+t.Name, fmt.Sprintf("successfully reverted chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainer), nil)
 	}
 	return nil
 }
 
 // abortWatcher continuously watch for the abort signals
-func abortWatcher(targets []targetDetails, resultName, chaosNS string) {
+func abortWatcher(ctx context.Context, targets []targetDetails, resultName, chaosNS string) {
 
 	<-abort
 
@@ -254,7 +262,7 @@ func abortWatcher(targets []targetDetails, resultName, chaosNS string) {
 	retry := 3
 	for retry > 0 {
 		for _, t := range targets {
-			if err = terminateProcess(t); err != nil {
+			if err = terminateProcess(ctx, t); err != nil {
 				log.Errorf("unable to revert for %v pod, err :%v", t.Name, err)
 				continue
 			}
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -137,7 +135,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 			experimentsDetails.TargetContainer = pod.Spec.Containers[0].Name
 		}
 
-		log.InfoWithValues("[Chaos]: The Target application details", logrus.Fields{
+		log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: The Target application details", logrus.Fields{
 			"Target Container":      experimentsDetails.TargetContainer,
 			"Target Pod":            pod.Name,
 			"Space Consumption(MB)": experimentsDetails.Size,
@@ -149,7 +147,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 		// signChan channel is used to transmit signal notifications.
 		signChan := make(chan os.Signal, 1)
 		// Catch and relay certain signal(s) to signChan channel.
-		signal.Notify(signChan, os.Interrupt, syscall.SIGTERM)
+		common.NotifyAbort(signChan)
 
 	loop:
 		for {
@@ -167,7 +165,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 					return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosInject, Target: fmt.Sprintf("podName: %s, namespace: %s, container: %s", pod.Name, pod.Namespace, experimentsDetails.TargetContainer), Reason: fmt.Sprintf("failed to stress cpu of target pod: %s", err.Error())}
 				}
 			case <-signChan:
-				log.Info("[Chaos]: Revert Started")
+				log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: Revert Started", nil)
 				if err := killStressSerial(experimentsDetails.TargetContainer, pod.Name, pod.Namespace, experimentsDetails.ChaosKillCmd, clients); err != nil {
 					log.Errorf("Error in Kill stress after abortion, err: %v", err)
 				}
@@ -177,7 +175,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 				if err := result.ChaosResult(chaosDetails, clients, resultDetails, "EOT"); err != nil {
 					log.Errorf("failed to update chaos result %s", err.Error())
 				}
-				log.Info("[Chaos]: Revert Completed")
+				log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: Revert Completed", nil)
 				os.Exit(1)
 			case <-endTime:
 				log.Infof("[Chaos]: Time is up for experiment: %v", experimentsDetails.ExperimentName)
@@ -221,7 +219,7 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 			experimentsDetails.TargetContainer = pod.Spec.Containers[0].Name
 		}
 
-		log.InfoWithValues("[Chaos]: The Target application details", logrus.Fields{
+		log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: The Target application details", logrus.Fields{
 			"Target Container":        experimentsDetails.TargetContainer,
 			"Target Pod":              pod.Name,
 			"Storage Consumption(MB)": experimentsDetails.Size,
@@ -234,7 +232,7 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 	// signChan channel is used to transmit signal notifications.
 	signChan := make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to signChan channel.
-	signal.Notify(signChan, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(signChan)
 loop:
 	for {
 		endTime = time.After(timeDelay)
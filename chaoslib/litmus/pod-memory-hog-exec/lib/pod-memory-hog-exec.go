@@ -4,10 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strconv"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -38,7 +36,7 @@ func PrepareMemoryExecStress(ctx context.Context, experimentsDetails *experiment
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -128,7 +126,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 	// signChan channel is used to transmit signal notifications.
 	signChan := make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to signChan channel.
-	signal.Notify(signChan, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(signChan)
 
 	var endTime <-chan time.Time
 	timeDelay := time.Duration(experimentsDetails.ChaosDuration) * time.Second
@@ -155,7 +153,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 				experimentsDetails.TargetContainer = pod.Spec.Containers[0].Name
 			}
 
-			log.InfoWithValues("[Chaos]: The Target application details", logrus.Fields{
+			log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: The Target application details", logrus.Fields{
 				"Target Container":       experimentsDetails.TargetContainer,
 				"Target Pod":             pod.Name,
 				"Memory Consumption(MB)": experimentsDetails.MemoryConsumption,
@@ -182,7 +180,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 						return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosInject, Target: fmt.Sprintf("podName: %s, namespace: %s, container: %s", pod.Name, pod.Namespace, experimentsDetails.TargetContainer), Reason: fmt.Sprintf("failed to stress memory of target pod: %s", err.Error())}
 					}
 				case <-signChan:
-					log.Info("[Chaos]: Revert Started")
+					log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: Revert Started", nil)
 					if err := killStressMemorySerial(experimentsDetails.TargetContainer, pod.Name, pod.Namespace, experimentsDetails.ChaosKillCmd, clients, chaosDetails); err != nil {
 						log.Errorf("Error in Kill stress after abortion, err: %v", err)
 					}
@@ -193,7 +191,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 					if err := result.ChaosResult(chaosDetails, clients, resultDetails, "EOT"); err != nil {
 						log.Errorf("failed to update chaos result %s", err.Error())
 					}
-					log.Info("[Chaos]: Revert Completed")
+					log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: Revert Completed", nil)
 					os.Exit(1)
 				case <-endTime:
 					log.Infof("[Chaos]: Time is up for experiment: %v", experimentsDetails.ExperimentName)
@@ -226,7 +224,7 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 	// signChan channel is used to transmit signal notifications.
 	signChan := make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to signChan channel.
-	signal.Notify(signChan, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(signChan)
 
 	var endTime <-chan time.Time
 	timeDelay := time.Duration(experimentsDetails.ChaosDuration) * time.Second
@@ -251,7 +249,7 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 				experimentsDetails.TargetContainer = pod.Spec.Containers[0].Name
 			}
 
-			log.InfoWithValues("[Chaos]: The Target application details", logrus.Fields{
+			log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: The Target application details", logrus.Fields{
 				"Target Container":       experimentsDetails.TargetContainer,
 				"Target Pod":             pod.Name,
 				"Memory Consumption(MB)": experimentsDetails.MemoryConsumption,
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -27,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkv1 "k8s.io/api/networking/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 var (
@@ -38,15 +37,19 @@ func PrepareAndInjectChaos(ctx context.Context, experimentsDetails *experimentTy
 	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "PreparePodNetworkPartitionFault")
 	defer span.End()
 
+	if chaosDetails.DryRun {
+		return planNetworkPolicy(experimentsDetails, clients, chaosDetails)
+	}
+
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	// validate the appLabels
 	if chaosDetails.AppDetail == nil {
@@ -148,7 +151,17 @@ func createNetworkPolicy(ctx context.Context, experimentsDetails *experimentType
 	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "InjectPodNetworkPartitionFault")
 	defer span.End()
 
-	np := &networkv1.NetworkPolicy{
+	np := buildNetworkPolicy(experimentsDetails, networkPolicy, runID)
+	_, err := clients.KubeClient.NetworkingV1().NetworkPolicies(experimentsDetails.AppNS).Create(context.Background(), np, v1.CreateOptions{})
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosInject, Reason: fmt.Sprintf("failed to create network policy: %s", err.Error())}
+	}
+	return nil
+}
+
+// buildNetworkPolicy returns the network policy which blocks the traffic of the target application
+func buildNetworkPolicy(experimentsDetails *experimentTypes.ExperimentDetails, networkPolicy *NetworkPolicy, runID string) *networkv1.NetworkPolicy {
+	return &networkv1.NetworkPolicy{
 		ObjectMeta: v1.ObjectMeta{
 			Name:      experimentsDetails.ExperimentName + "-np-" + runID,
 			Namespace: experimentsDetails.AppNS,
@@ -167,11 +180,42 @@ func createNetworkPolicy(ctx context.Context, experimentsDetails *experimentType
 			Ingress:     networkPolicy.Ingress,
 		},
 	}
+}
 
-	_, err := clients.KubeClient.NetworkingV1().NetworkPolicies(experimentsDetails.AppNS).Create(context.Background(), np, v1.CreateOptions{})
+// planNetworkPolicy records the network policy inside the plan, without creating it
+func planNetworkPolicy(experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
+	if chaosDetails.AppDetail == nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "provide the appLabel"}
+	}
+	targetPodList, err := common.GetPodList("", 100, clients, chaosDetails)
 	if err != nil {
-		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosInject, Reason: fmt.Sprintf("failed to create network policy: %s", err.Error())}
+		return stacktrace.Propagate(err, "could not get target pods")
+	}
+
+	networkPolicy := initialize()
+	if err := networkPolicy.getNetworkPolicyDetails(experimentsDetails); err != nil {
+		return stacktrace.Propagate(err, "could not get network policy details")
+	}
+	np := buildNetworkPolicy(experimentsDetails, networkPolicy, stringutils.GetRunID())
+
+	podNames, policyTypes, ports := []string{}, []string{}, []string{}
+	for _, pod := range targetPodList.Items {
+		podNames = append(podNames, pod.Name)
+	}
+	for _, policyType := range np.Spec.PolicyTypes {
+		policyTypes = append(policyTypes, string(policyType))
+	}
+	for _, port := range networkPolicy.Ports {
+		ports = append(ports, fmt.Sprintf("%s/%s", *port.Protocol, port.Port.String()))
 	}
+	common.RecordPlanStep("create network policy", np.Name, map[string]string{
+		"namespace":      np.Namespace,
+		"podSelector":    labels.FormatLabels(np.Spec.PodSelector.MatchLabels),
+		"policyTypes":    strings.Join(policyTypes, ","),
+		"destinationIPs": strings.Join(networkPolicy.ExceptIPs, ","),
+		"ports":          strings.Join(ports, ","),
+		"targets":        strings.Join(podNames, ","),
+	}, chaosDetails)
 	return nil
 }
 
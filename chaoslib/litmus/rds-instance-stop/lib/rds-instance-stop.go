@@ -5,9 +5,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"golang.org/x/net/context"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -34,15 +32,19 @@ func PrepareRDSInstanceStop(ctx context.Context, experimentsDetails *experimentT
 	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "PrepareRDSInstanceStop")
 	defer span.End()
 
+	if chaosDetails.DryRun {
+		return planRDSInstanceStop(experimentsDetails, chaosDetails)
+	}
+
 	// Inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// Abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	// Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -50,13 +52,10 @@ func PrepareRDSInstanceStop(ctx context.Context, experimentsDetails *experimentT
 		common.WaitForDuration(experimentsDetails.RampTime)
 	}
 
-	// Get the instance identifier or list of instance identifiers
-	instanceIdentifierList := stringutils.SplitList(experimentsDetails.RDSInstanceIdentifier)
-	if len(instanceIdentifierList) == 0 {
-		return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "no RDS instance identifier found to stop"}
+	instanceIdentifierList, err := selectTargetInstances(experimentsDetails, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
 	}
-
-	instanceIdentifierList = common.FilterBasedOnPercentage(experimentsDetails.InstanceAffectedPerc, instanceIdentifierList)
 	log.Infof("[Chaos]:Number of Instance targeted: %v", len(instanceIdentifierList))
 
 	// Watching for the abort signal and revert the chaos
@@ -259,3 +258,34 @@ func abortWatcher(experimentsDetails *experimentTypes.ExperimentDetails, instanc
 	log.Info("[Abort]: Chaos Revert Completed")
 	os.Exit(1)
 }
+
+// selectTargetInstances selects the target instances out of the given instance identifiers, based on the affected percentage
+func selectTargetInstances(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) ([]string, error) {
+	// Get the instance identifier or list of instance identifiers
+	instanceIdentifierList := stringutils.SplitList(experimentsDetails.RDSInstanceIdentifier)
+	if len(instanceIdentifierList) == 0 {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: "no RDS instance identifier found to stop"}
+	}
+
+	candidates := len(instanceIdentifierList)
+	instanceIdentifierList = common.FilterBasedOnPercentage(experimentsDetails.InstanceAffectedPerc, instanceIdentifierList)
+	if err := common.CheckInstanceGuardrails("instances", instanceIdentifierList, candidates, chaosDetails); err != nil {
+		return nil, err
+	}
+	return instanceIdentifierList, nil
+}
+
+// planRDSInstanceStop records the stop of the target instances inside the plan, without stopping them
+func planRDSInstanceStop(experimentsDetails *experimentTypes.ExperimentDetails, chaosDetails *types.ChaosDetails) error {
+	targets, err := selectTargetInstances(experimentsDetails, chaosDetails)
+	if err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
+	}
+	for _, target := range targets {
+		common.RecordPlanStep("stop rds instance", target, map[string]string{
+			"region":   experimentsDetails.Region,
+			"sequence": experimentsDetails.Sequence,
+		}, chaosDetails)
+	}
+	return nil
+}
@@ -0,0 +1,343 @@
+package helper
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/palantir/stacktrace"
+	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	clients "github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/journal"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/registry"
+	"github.com/litmuschaos/litmus-go/pkg/telemetry"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils"
+	"github.com/litmuschaos/litmus-go/pkg/utils/common"
+)
+
+func init() {
+	registry.RegisterHelper("revert", "Reverts the leftover chaos on the node", Helper)
+}
+
+// orphanProcesses contains the names of the processes launched by the helpers inside the target containers
+// stress-ng workers are named after their stressor, i.e, stress-ng-cpu, hence it is matched as a prefix
+// only the processes marked by the helpers are killed, the processes of the users with the same names are left untouched
+var orphanProcesses = []string{"dns_interceptor", "toxiproxy-server", "stress-ng"}
+
+// commLength is the maximum length of the process names inside the comm file
+const commLength = 15
+
+// diskFillPath is the file created by the disk-fill helper inside the target containers
+const diskFillPath = "/home/diskfill"
+
+// redirectRule matches the iptables rule, added by the http-chaos helper to redirect the traffic to the proxy
+// the rule is marked by the comment, which contains the chaos uid
+var redirectRule = regexp.MustCompile(`^-A PREROUTING -i \S+ -p tcp -m tcp --dport \d+ -m comment --comment ` + regexp.QuoteMeta(common.ChaosMarkPrefix) + `\S+ -j REDIRECT --to-ports \d+$`)
+
+// revertDetails contains the inputs of the revert helper
+type revertDetails struct {
+	ChaosNamespace   string
+	ChaosPodName     string
+	NodeName         string
+	ContainerRuntime string
+	SocketPath       string
+}
+
+// Helper reverts the leftover chaos of the failed runs on the node, where it is running
+// it reconciles the revert journal of the node, then scans the pods and the processes of the node for the leftover faults
+func Helper(ctx context.Context, clients clients.ClientSets) {
+	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "RevertLeftoverFaults")
+	defer span.End()
+
+	details := revertDetails{}
+
+	//Fetching all the ENV passed for the helper pod
+	log.Info("[PreReq]: Getting the ENV variables")
+	getENV(&details)
+
+	cleaned, err := revert(&details, clients)
+	if len(cleaned) == 0 {
+		log.Info("[Revert]: No leftover chaos found on the node")
+	}
+	for _, c := range cleaned {
+		log.Infof("[Revert]: %v", c)
+	}
+	if err != nil {
+		log.Fatalf("helper pod failed, err: %v", err)
+	}
+}
+
+// revert reverts the leftover chaos on the node and returns what it has cleaned
+func revert(details *revertDetails, clients clients.ClientSets) ([]string, error) {
+	if details.NodeName == "" {
+		pod, err := clients.KubeClient.CoreV1().Pods(details.ChaosNamespace).Get(context.Background(), details.ChaosPodName, v1.GetOptions{})
+		if err != nil {
+			return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeHelper, Source: details.ChaosPodName, Target: fmt.Sprintf("{podName: %s, namespace: %s}", details.ChaosPodName, details.ChaosNamespace), Reason: fmt.Sprintf("failed to get the node of the helper pod: %s", err.Error())}
+		}
+		details.NodeName = pod.Spec.NodeName
+	}
+	log.Infof("[Revert]: Reverting the leftover chaos on the %v node", details.NodeName)
+
+	var cleaned []string
+
+	// the journal contains the exact mutations of the helpers, it is reconciled first
+	reverted, err := journal.ForNode(clients, details.ChaosNamespace, details.NodeName, details.ChaosPodName).Reconcile()
+	for _, entry := range reverted {
+		cleaned = append(cleaned, fmt.Sprintf("journal: reverted %s on {pod: %s, namespace: %s, container: %s}", entry.Fault, entry.Pod, entry.Namespace, entry.Container))
+	}
+	if err != nil {
+		log.Warnf("[Revert]: Unable to reconcile the revert journal, err: %v", err)
+	}
+
+	pods, err := clients.KubeClient.CoreV1().Pods("").List(context.Background(), v1.ListOptions{FieldSelector: "spec.nodeName=" + details.NodeName})
+	if err != nil {
+		return cleaned, cerrors.Error{ErrorCode: cerrors.ErrorTypeHelper, Source: details.ChaosPodName, Target: fmt.Sprintf("{node: %s}", details.NodeName), Reason: fmt.Sprintf("failed to list the pods: %s", err.Error())}
+	}
+
+	// the faults of the running helpers can't be distinguished from the leftovers, the scan is skipped until they complete
+	if helpers := activeHelpers(pods.Items, details.ChaosPodName); len(helpers) != 0 {
+		log.Warnf("[Revert]: Skipping the scan of the node, as the %v helpers are still running", strings.Join(helpers, ", "))
+		return cleaned, nil
+	}
+
+	var errList []string
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.Labels["chaosUID"] != "" {
+			continue
+		}
+		podCleaned, err := revertPod(details, pod, clients)
+		cleaned = append(cleaned, podCleaned...)
+		if err != nil {
+			errList = append(errList, err.Error())
+		}
+	}
+
+	processCleaned, err := killOrphanProcesses(details.ChaosPodName)
+	cleaned = append(cleaned, processCleaned...)
+	if err != nil {
+		errList = append(errList, err.Error())
+	}
+
+	if len(errList) != 0 {
+		return cleaned, cerrors.PreserveError{ErrString: fmt.Sprintf("[%s]", strings.Join(errList, ","))}
+	}
+	return cleaned, nil
+}
+
+// activeHelpers returns the running chaos helpers of the node, other than the revert helper itself
+func activeHelpers(pods []corev1.Pod, self string) []string {
+	var helpers []string
+	for _, pod := range pods {
+		if pod.Name == self || pod.Labels["chaosUID"] == "" || !strings.Contains(pod.Labels["app"], "-helper-") {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodPending || pod.Status.Phase == corev1.PodRunning {
+			helpers = append(helpers, pod.Namespace+"/"+pod.Name)
+		}
+	}
+	return helpers
+}
+
+// revertPod removes the leftover network and disk faults of the given pod
+func revertPod(details *revertDetails, pod corev1.Pod, clients clients.ClientSets) ([]string, error) {
+	var cleaned []string
+	var errList []string
+
+	// the network faults are applied inside the pod sandbox, it is shared with the host for the host network pods
+	if !pod.Spec.HostNetwork && len(pod.Spec.Containers) != 0 {
+		containerID, err := common.GetRuntimeBasedContainerID(details.ContainerRuntime, details.SocketPath, pod.Name, pod.Namespace, pod.Spec.Containers[0].Name, clients, details.ChaosPodName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "could not get container id")
+		}
+		nsPath, err := common.GetNetworkNsPath(details.ContainerRuntime, containerID, details.SocketPath, details.ChaosPodName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "could not get container network ns path")
+		}
+
+		netCleaned, err := revertNetwork(nsPath, details.ChaosPodName)
+		for _, c := range netCleaned {
+			cleaned = append(cleaned, fmt.Sprintf("%s on {pod: %s, namespace: %s}", c, pod.Name, pod.Namespace))
+		}
+		if err != nil {
+			errList = append(errList, err.Error())
+		}
+	}
+
+	for _, container := range pod.Status.ContainerStatuses {
+		if container.State.Running == nil || container.ContainerID == "" {
+			continue
+		}
+		pid, err := common.GetPID(details.ContainerRuntime, strings.Split(container.ContainerID, "//")[1], details.SocketPath, details.ChaosPodName)
+		if err != nil {
+			errList = append(errList, err.Error())
+			continue
+		}
+		path := fmt.Sprintf("/proc/%d/root%s", pid, diskFillPath)
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		if err := os.RemoveAll(path); err != nil {
+			errList = append(errList, cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Source: details.ChaosPodName, Target: fmt.Sprintf("{podName: %s, namespace: %s, container: %s}", pod.Name, pod.Namespace, container.Name), Reason: fmt.Sprintf("failed to remove %s: %s", diskFillPath, err.Error())}.Error())
+			continue
+		}
+		cleaned = append(cleaned, fmt.Sprintf("disk-fill: removed %s on {pod: %s, namespace: %s, container: %s}", diskFillPath, pod.Name, pod.Namespace, container.Name))
+	}
+
+	if len(errList) != 0 {
+		return cleaned, cerrors.PreserveError{ErrString: fmt.Sprintf("[%s]", strings.Join(errList, ","))}
+	}
+	return cleaned, nil
+}
+
+// revertNetwork removes the marked netem qdiscs and proxy redirect rules inside the given network ns
+func revertNetwork(nsPath, source string) ([]string, error) {
+	var cleaned []string
+
+	out, err := exec.Command("sudo", "nsenter", "--net="+nsPath, "tc", "qdisc", "show").CombinedOutput()
+	if err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Source: source, Target: fmt.Sprintf("{netns: %s}", nsPath), Reason: fmt.Sprintf("failed to list the qdiscs: %s", string(out))}
+	}
+	for _, dev := range netemDevices(string(out)) {
+		if out, err := exec.Command("sudo", "nsenter", "--net="+nsPath, "tc", "qdisc", "delete", "dev", dev, "root").CombinedOutput(); err != nil {
+			return cleaned, cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Source: source, Target: fmt.Sprintf("{netns: %s, interface: %s}", nsPath, dev), Reason: fmt.Sprintf("failed to delete the root qdisc: %s", string(out))}
+		}
+		cleaned = append(cleaned, fmt.Sprintf("network-chaos: deleted the netem root qdisc of %s", dev))
+	}
+
+	out, err = exec.Command("sudo", "nsenter", "--net="+nsPath, "iptables", "-t", "nat", "-S", "PREROUTING").CombinedOutput()
+	if err != nil {
+		// iptables is not mandatory inside the pod sandbox, there is no rule to remove without it
+		log.Warnf("[Revert]: Unable to list the iptables rules of %v, err: %v", nsPath, string(out))
+		return cleaned, nil
+	}
+	for _, rule := range redirectRules(string(out)) {
+		args := append([]string{"nsenter", "--net=" + nsPath, "iptables", "-t", "nat", "-D"}, strings.Fields(rule)[1:]...)
+		if out, err := exec.Command("sudo", args...).CombinedOutput(); err != nil {
+			return cleaned, cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Source: source, Target: fmt.Sprintf("{netns: %s}", nsPath), Reason: fmt.Sprintf("failed to delete the %q rule: %s", rule, string(out))}
+		}
+		cleaned = append(cleaned, fmt.Sprintf("http-chaos: deleted the %q iptables rule", rule))
+	}
+	return cleaned, nil
+}
+
+// netemDevices returns the interfaces with a netem qdisc of the helpers, from the output of tc qdisc show
+// the network-chaos helper adds the netem qdisc with its own handle, either as the root qdisc or under a root prio qdisc
+func netemDevices(out string) []string {
+	var devices []string
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		// qdisc netem ca05: dev eth0 root refcnt 2 limit 1000 delay 2.0s
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 || fields[0] != "qdisc" || fields[1] != "netem" || fields[2] != common.NetemHandle || fields[3] != "dev" {
+			continue
+		}
+		if !utils.Contains(fields[4], devices) {
+			devices = append(devices, fields[4])
+		}
+	}
+	return devices
+}
+
+// redirectRules returns the proxy redirect rules, from the output of iptables -S PREROUTING
+func redirectRules(out string) []string {
+	var rules []string
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		if rule := strings.TrimSpace(scanner.Text()); redirectRule.MatchString(rule) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// killOrphanProcesses kills the processes launched by the helpers, which are left behind on the node
+// the revert helper runs with the host pid namespace, so that all the processes of the node are visible
+// the processes are identified by their names and the mark inside their ENVs
+func killOrphanProcesses(source string) ([]string, error) {
+	procs, err := filepath.Glob("/proc/[0-9]*/comm")
+	if err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeHelper, Source: source, Reason: fmt.Sprintf("failed to list the processes: %s", err.Error())}
+	}
+
+	var cleaned []string
+	var errList []string
+	for _, proc := range procs {
+		comm, err := os.ReadFile(proc)
+		if err != nil {
+			// the process is already finished
+			continue
+		}
+		name := strings.TrimSpace(string(comm))
+		if !isOrphanProcess(name) {
+			continue
+		}
+		pid, err := strconv.Atoi(filepath.Base(filepath.Dir(proc)))
+		if err != nil {
+			continue
+		}
+		environ, err := os.ReadFile(filepath.Join(filepath.Dir(proc), "environ"))
+		if err != nil || !hasChaosMark(environ) {
+			continue
+		}
+		cmdline := name
+		if out, err := os.ReadFile(filepath.Join(filepath.Dir(proc), "cmdline")); err == nil && len(out) != 0 {
+			cmdline = strings.TrimSpace(strings.ReplaceAll(string(out), "\x00", " "))
+		}
+		if err := syscall.Kill(pid, syscall.SIGKILL); err != nil && err != syscall.ESRCH {
+			errList = append(errList, cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Source: source, Target: fmt.Sprintf("{pid: %d, process: %s}", pid, name), Reason: fmt.Sprintf("failed to kill the process: %s", err.Error())}.Error())
+			continue
+		}
+		cleaned = append(cleaned, fmt.Sprintf("process: killed %q with pid %d", cmdline, pid))
+	}
+
+	if len(errList) != 0 {
+		return cleaned, cerrors.PreserveError{ErrString: fmt.Sprintf("[%s]", strings.Join(errList, ","))}
+	}
+	return cleaned, nil
+}
+
+// isOrphanProcess checks whether the given process is launched by the helpers
+// the process names are truncated to 15 characters inside the comm file
+func isOrphanProcess(name string) bool {
+	for _, process := range orphanProcesses {
+		if len(process) > commLength {
+			process = process[:commLength]
+		}
+		if name == process || strings.HasPrefix(name, process+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// hasChaosMark checks whether the given ENVs of a process, read from its environ file, contain the mark of the helpers
+func hasChaosMark(environ []byte) bool {
+	for _, env := range strings.Split(string(environ), "\x00") {
+		if strings.HasPrefix(env, common.ChaosMarkEnv+"="+common.ChaosMarkPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// getENV fetches all the env variables from the runner pod
+func getENV(details *revertDetails) {
+	details.ChaosNamespace = types.Getenv("CHAOS_NAMESPACE", "litmus")
+	details.ChaosPodName = types.Getenv("POD_NAME", "")
+	details.NodeName = types.Getenv("NODE_NAME", "")
+	details.ContainerRuntime = types.Getenv("CONTAINER_RUNTIME", "containerd")
+	details.SocketPath = types.Getenv("SOCKET_PATH", "/run/containerd/containerd.sock")
+}
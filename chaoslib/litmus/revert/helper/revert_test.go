@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetemDevices(t *testing.T) {
+	out := `qdisc noqueue 0: dev lo root refcnt 2
+qdisc prio 1: dev eth0 root refcnt 2 bands 3 priomap 1 2 2 2 1 2 0 0 1 1 1 1 1 1 1 1
+qdisc netem ca05: dev eth0 parent 1:3 limit 1000 delay 2.0s
+qdisc netem ca05: dev eth1 root refcnt 2 limit 1000 loss 100%
+qdisc netem 8001: dev eth2 root refcnt 2 limit 1000 delay 10ms
+`
+	assert.Equal(t, []string{"eth0", "eth1"}, netemDevices(out))
+}
+
+func TestRedirectRules(t *testing.T) {
+	out := `-P PREROUTING ACCEPT
+-A PREROUTING -p tcp -j ISTIO_INBOUND
+-A PREROUTING -i eth0 -p tcp -m tcp --dport 80 -m comment --comment litmus-3f6c1a2e -j REDIRECT --to-ports 20000
+-A PREROUTING -i eth0 -p tcp -m tcp --dport 8080 -j REDIRECT --to-ports 15001
+`
+	assert.Equal(t, []string{"-A PREROUTING -i eth0 -p tcp -m tcp --dport 80 -m comment --comment litmus-3f6c1a2e -j REDIRECT --to-ports 20000"}, redirectRules(out))
+}
+
+func TestIsOrphanProcess(t *testing.T) {
+	for name, expected := range map[string]bool{
+		"dns_interceptor": true,
+		"toxiproxy-serve": true,
+		"stress-ng":       true,
+		"stress-ng-cpu":   true,
+		"stress-ngx":      false,
+		"nginx":           false,
+	} {
+		assert.Equal(t, expected, isOrphanProcess(name), name)
+	}
+}
+
+func TestHasChaosMark(t *testing.T) {
+	assert.True(t, hasChaosMark([]byte("PATH=/usr/bin\x00LITMUS_CHAOS_MARK=litmus-3f6c1a2e\x00")))
+	assert.False(t, hasChaosMark([]byte("PATH=/usr/bin\x00HOME=/root\x00")))
+	assert.False(t, hasChaosMark(nil))
+}
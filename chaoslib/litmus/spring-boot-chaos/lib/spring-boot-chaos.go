@@ -7,9 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -194,7 +192,7 @@ func setChaosMonkeyAssault(chaosMonkeyPort string, chaosMonkeyPath string, assau
 
 // disableChaosMonkey disables chaos monkey on selected pods
 func disableChaosMonkey(ctx context.Context, chaosMonkeyPort string, chaosMonkeyPath string, pod corev1.Pod) error {
-	log.Infof("[Chaos]: disabling assaults on pod %s", pod.Name)
+	log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: disabling assaults", nil)
 	jsonValue, err := json.Marshal(revertAssault)
 	if err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{podName: %s, namespace: %s}", pod.Name, pod.Namespace), Reason: fmt.Sprintf("failed to marshal chaos monkey revert-chaos watchers, %s", err.Error())}
@@ -203,7 +201,7 @@ func disableChaosMonkey(ctx context.Context, chaosMonkeyPort string, chaosMonkey
 		return err
 	}
 
-	log.Infof("[Chaos]: disabling chaos monkey on pod %s", pod.Name)
+	log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: disabling chaos monkey", nil)
 	resp, err := http.Post("http://"+pod.Status.PodIP+":"+chaosMonkeyPort+chaosMonkeyPath+"/disable", "", nil)
 	if err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Target: fmt.Sprintf("{podName: %s, namespace: %s}", pod.Name, pod.Namespace), Reason: fmt.Sprintf("failed to call the chaos monkey api to disable assault, %s", err.Error())}
@@ -231,7 +229,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 	// signChan channel is used to transmit signal notifications.
 	signChan := make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to signChan channel.
-	signal.Notify(signChan, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(signChan)
 
 	var endTime <-chan time.Time
 	timeDelay := time.Duration(experimentsDetails.ChaosDuration) * time.Second
@@ -249,7 +247,7 @@ func injectChaosInSerialMode(ctx context.Context, experimentsDetails *experiment
 				_ = events.GenerateEvents(eventsDetails, clients, chaosDetails, "ChaosEngine")
 			}
 
-			log.InfoWithValues("[Chaos]: Injecting on target pod", logrus.Fields{
+			log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: Injecting on target pod", logrus.Fields{
 				"Target Pod": pod.Name,
 			})
 
@@ -321,7 +319,7 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 	// signChan channel is used to transmit signal notifications.
 	signChan := make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to signChan channel.
-	signal.Notify(signChan, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(signChan)
 
 	var endTime <-chan time.Time
 	timeDelay := time.Duration(experimentsDetails.ChaosDuration) * time.Second
@@ -339,7 +337,7 @@ func injectChaosInParallelMode(ctx context.Context, experimentsDetails *experime
 				_ = events.GenerateEvents(eventsDetails, clients, chaosDetails, "ChaosEngine")
 			}
 
-			log.InfoWithValues("[Chaos]: The Target application details", logrus.Fields{
+			log.InfoWithTarget(ctx, "pod/"+pod.Name, "[Chaos]: The Target application details", logrus.Fields{
 				"Target Pod": pod.Name,
 			})
 
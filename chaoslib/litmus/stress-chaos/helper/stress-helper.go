@@ -28,7 +28,9 @@ import (
 	clients "github.com/litmuschaos/litmus-go/pkg/clients"
 	"github.com/litmuschaos/litmus-go/pkg/events"
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/generic/stress-chaos/types"
+	"github.com/litmuschaos/litmus-go/pkg/journal"
 	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/registry"
 	"github.com/litmuschaos/litmus-go/pkg/result"
 	"github.com/litmuschaos/litmus-go/pkg/telemetry"
 	"github.com/litmuschaos/litmus-go/pkg/types"
@@ -36,6 +38,14 @@ import (
 	"github.com/litmuschaos/litmus-go/pkg/utils/common"
 )
 
+func init() {
+	registry.RegisterHelper("stress-chaos", "Injects the stress chaos inside the target containers", Helper)
+	journal.RegisterReverter(fault, revertJournalEntry)
+}
+
+// fault is the name of the stress chaos inside the revert journal
+const fault = "stress-chaos"
+
 // list of cgroups in a container
 var (
 	cgroupSubsystemList = []string{"cpu", "memory", "systemd", "net_cls",
@@ -47,6 +57,7 @@ var (
 var (
 	err           error
 	inject, abort chan os.Signal
+	revertJournal *journal.Journal
 )
 
 const (
@@ -81,7 +92,9 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 	getENV(&experimentsDetails)
 
 	// Intialise the chaos attributes
-	types.InitialiseChaosVariables(&chaosDetails)
+	if err := types.InitialiseChaosVariables(&chaosDetails); err != nil {
+		log.Fatalf("helper pod failed, err: %v", err)
+	}
 	chaosDetails.Phase = types.ChaosInjectPhase
 
 	// Intialise Chaos Result Parameters
@@ -90,7 +103,7 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 	// Set the chaos result uid
 	result.SetResultUID(&resultDetails, clients, &chaosDetails)
 
-	if err := prepareStressChaos(&experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails); err != nil {
+	if err := prepareStressChaos(ctx, &experimentsDetails, clients, &eventsDetails, &chaosDetails, &resultDetails); err != nil {
 		// update failstep inside chaosresult
 		if resultErr := result.UpdateFailedStepFromHelper(&resultDetails, &chaosDetails, clients, err); resultErr != nil {
 			log.Fatalf("helper pod failed, err: %v, resultErr: %v", err, resultErr)
@@ -100,7 +113,7 @@ func Helper(ctx context.Context, clients clients.ClientSets) {
 }
 
 // prepareStressChaos contains the chaos preparation and injection steps
-func prepareStressChaos(experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
+func prepareStressChaos(ctx context.Context, experimentsDetails *experimentTypes.ExperimentDetails, clients clients.ClientSets, eventsDetails *types.EventDetails, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) error {
 	// get stressors in list format
 	stressorList := prepareStressor(experimentsDetails)
 	if len(stressorList) == 0 {
@@ -159,8 +172,16 @@ func prepareStressChaos(experimentsDetails *experimentTypes.ExperimentDetails, c
 		targets = append(targets, td)
 	}
 
+	// the revert journal keeps track of the stress processes, so that they are killed even if the helper gets killed
+	if revertJournal, err = journal.New(clients, chaosDetails.ChaosNamespace, chaosDetails.ChaosPodName); err != nil {
+		log.Warnf("Unable to open the revert journal, err: %v", err)
+	}
+	if _, err := revertJournal.Reconcile(fault); err != nil {
+		log.Warnf("Unable to revert the leftover faults, err: %v", err)
+	}
+
 	// watching for the abort signal and revert the chaos if an abort signal is received
-	go abortWatcher(targets, resultDetails.Name, chaosDetails.ChaosNamespace)
+	go abortWatcher(ctx, targets, resultDetails.Name, chaosDetails.ChaosNamespace)
 
 	select {
 	case <-inject:
@@ -173,19 +194,19 @@ func prepareStressChaos(experimentsDetails *experimentTypes.ExperimentDetails, c
 
 	for index, t := range targets {
 		for i := range t.Pids {
-			cmd, err := injectChaos(t, stressors, i, experimentsDetails.StressType)
+			cmd, err := injectChaos(t, stressors, i, experimentsDetails.StressType, resultDetails.Name, common.ChaosMark(string(experimentsDetails.ChaosUID)))
 			if err != nil {
-				if revertErr := revertChaosForAllTargets(targets, resultDetails, chaosDetails.ChaosNamespace, index-1); revertErr != nil {
+				if revertErr := revertChaosForAllTargets(ctx, targets, resultDetails, chaosDetails.ChaosNamespace, index-1); revertErr != nil {
 					return cerrors.PreserveError{ErrString: fmt.Sprintf("[%s,%s]", stacktrace.RootCause(err).Error(), stacktrace.RootCause(revertErr).Error())}
 				}
 				return stacktrace.Propagate(err, "could not inject chaos")
 			}
 			targets[index].Cmds = append(targets[index].Cmds, cmd)
-			log.Infof("successfully injected chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainers[i])
+			log.InfoWithTarget(ctx, "pod/"+t.Name, fmt.Sprintf("successfully injected chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainers[i]), nil)
 		}
 
 		if err = result.AnnotateChaosResult(resultDetails.Name, chaosDetails.ChaosNamespace, "injected", "pod", t.Name); err != nil {
-			if revertErr := revertChaosForAllTargets(targets, resultDetails, chaosDetails.ChaosNamespace, index); revertErr != nil {
+			if revertErr := revertChaosForAllTargets(ctx, targets, resultDetails, chaosDetails.ChaosNamespace, index); revertErr != nil {
 				return cerrors.PreserveError{ErrString: fmt.Sprintf("[%s,%s]", stacktrace.RootCause(err).Error(), stacktrace.RootCause(revertErr).Error())}
 			}
 			return stacktrace.Propagate(err, "could not annotate chaosresult")
@@ -248,7 +269,7 @@ func prepareStressChaos(experimentsDetails *experimentTypes.ExperimentDetails, c
 		// the stress process gets timeout before completion
 		log.Infof("[Chaos] The stress process is not yet completed after the chaos duration of %vs", experimentsDetails.ChaosDuration+30)
 		log.Info("[Timeout]: Killing the stress process")
-		if err := revertChaosForAllTargets(targets, resultDetails, chaosDetails.ChaosNamespace, len(targets)-1); err != nil {
+		if err := revertChaosForAllTargets(ctx, targets, resultDetails, chaosDetails.ChaosNamespace, len(targets)-1); err != nil {
 			return stacktrace.Propagate(err, "could not revert chaos")
 		}
 	case err := <-done:
@@ -268,7 +289,7 @@ func prepareStressChaos(experimentsDetails *experimentTypes.ExperimentDetails, c
 			return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosInject, Source: chaosDetails.ChaosPodName, Reason: err.Error()}
 		}
 		log.Info("[Info]: Reverting Chaos")
-		if err := revertChaosForAllTargets(targets, resultDetails, chaosDetails.ChaosNamespace, len(targets)-1); err != nil {
+		if err := revertChaosForAllTargets(ctx, targets, resultDetails, chaosDetails.ChaosNamespace, len(targets)-1); err != nil {
 			return stacktrace.Propagate(err, "could not revert chaos")
 		}
 	}
@@ -276,10 +297,10 @@ func prepareStressChaos(experimentsDetails *experimentTypes.ExperimentDetails, c
 	return nil
 }
 
-func revertChaosForAllTargets(targets []*targetDetails, resultDetails *types.ResultDetails, chaosNs string, index int) error {
+func revertChaosForAllTargets(ctx context.Context, targets []*targetDetails, resultDetails *types.ResultDetails, chaosNs string, index int) error {
 	var errList []string
 	for i := 0; i <= index; i++ {
-		if err := terminateProcess(targets[i]); err != nil {
+		if err := terminateProcess(ctx, targets[i]); err != nil {
 			errList = append(errList, err.Error())
 			continue
 		}
@@ -324,18 +345,20 @@ func checkOOMKilled(targets []*targetDetails, clients clients.ClientSets, chaosE
 }
 
 // terminateProcess will remove the stress process from the target container after chaos completion
-func terminateProcess(t *targetDetails) error {
+func terminateProcess(ctx context.Context, t *targetDetails) error {
 	var errList []string
 	for i := range t.Cmds {
 		if t.Cmds[i] != nil && t.Cmds[i].Cmd.Process != nil {
 			if err := syscall.Kill(-t.Cmds[i].Cmd.Process.Pid, syscall.SIGKILL); err != nil {
 				if strings.Contains(err.Error(), ProcessAlreadyKilled) || strings.Contains(err.Error(), ProcessAlreadyFinished) {
+					removeJournalEntry(t, i)
 					continue
 				}
 				errList = append(errList, cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Source: t.Source, Target: fmt.Sprintf("{podName: %s, namespace: %s, container: %s}", t.Name, t.Namespace, t.TargetContainers[i]), Reason: fmt.Sprintf("failed to revert chaos: %s", err.Error())}.Error())
 				continue
 			}
-			log.Infof("successfully reverted chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainers[i])
+			removeJournalEntry(t, i)
+			log.InfoWithTarget(ctx, "pod/"+t.Name, fmt.Sprintf("successfully reverted chaos on target: {name: %s, namespace: %v, container: %v}", t.Name, t.Namespace, t.TargetContainers[i]), nil)
 		}
 	}
 	if len(errList) != 0 {
@@ -556,7 +579,7 @@ func getENV(experimentDetails *experimentTypes.ExperimentDetails) {
 }
 
 // abortWatcher continuously watch for the abort signals
-func abortWatcher(targets []*targetDetails, resultName, chaosNS string) {
+func abortWatcher(ctx context.Context, targets []*targetDetails, resultName, chaosNS string) {
 
 	<-abort
 
@@ -566,7 +589,7 @@ func abortWatcher(targets []*targetDetails, resultName, chaosNS string) {
 	retry := 3
 	for retry > 0 {
 		for _, t := range targets {
-			if err = terminateProcess(t); err != nil {
+			if err = terminateProcess(ctx, t); err != nil {
 				log.Errorf("[Abort]: unable to revert for %v pod, err :%v", t.Name, err)
 				continue
 			}
@@ -636,7 +659,7 @@ func addProcessToCgroup(pid int, control interface{}, groupPath string) error {
 	return cgroup1.Add(cgroups.Process{Pid: pid})
 }
 
-func injectChaos(t *targetDetails, stressors string, index int, stressType string) (*Command, error) {
+func injectChaos(t *targetDetails, stressors string, index int, stressType, resultName, mark string) (*Command, error) {
 	stressCommand := fmt.Sprintf("pause nsutil -t %v -p -- %v", strconv.Itoa(t.Pids[index]), stressors)
 	// for io stress,we need to enter into mount ns of the target container
 	// enabling it by passing -m flag
@@ -647,6 +670,8 @@ func injectChaos(t *targetDetails, stressors string, index int, stressType strin
 
 	// launch the stress-ng process on the target container in paused mode
 	cmd := exec.Command("/bin/bash", "-c", stressCommand)
+	// the stress process is marked by the ENV, so that the revert helper kills only the stress processes of the helpers
+	cmd.Env = append(os.Environ(), common.ChaosMarkEnv+"="+mark)
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
 	var buf bytes.Buffer
 	cmd.Stdout = &buf
@@ -656,6 +681,11 @@ func injectChaos(t *targetDetails, stressors string, index int, stressType strin
 		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosInject, Source: t.Source, Target: fmt.Sprintf("{podName: %s, namespace: %s, container: %s}", t.Name, t.Namespace, t.TargetContainers[index]), Reason: fmt.Sprintf("failed to start stress process: %s", err.Error())}
 	}
 
+	// the stress process is still paused, it is recorded before resuming it
+	if err := revertJournal.Record(journalEntry(t, index, cmd.Process.Pid, resultName)); err != nil {
+		log.Warnf("Unable to record the chaos inside the revert journal, err: %v", err)
+	}
+
 	// add the stress process to the cgroup of target container
 	if err = addProcessToCgroup(cmd.Process.Pid, t.CGroupManagers[index], t.GroupPath); err != nil {
 		if killErr := cmd.Process.Kill(); killErr != nil {
@@ -679,6 +709,63 @@ func injectChaos(t *targetDetails, stressors string, index int, stressType strin
 	}, nil
 }
 
+// journalEntry returns the revert journal entry of the stress process of the given target container
+// the start time of the process is recorded along with its pid, so that a reused pid is never killed
+func journalEntry(t *targetDetails, index, pid int, resultName string) journal.Entry {
+	entry := journal.Entry{
+		Fault:     fault,
+		Pod:       t.Name,
+		Namespace: t.Namespace,
+		Container: t.TargetContainers[index],
+		Result:    resultName,
+		Details:   map[string]string{"pgid": strconv.Itoa(pid)},
+	}
+	if startTime, err := processStartTime(pid); err == nil {
+		entry.Details["startTime"] = startTime
+	}
+	return entry
+}
+
+// removeJournalEntry removes the entry of the given target container from the revert journal, once the chaos is reverted
+func removeJournalEntry(t *targetDetails, index int) {
+	entry := journal.Entry{Fault: fault, Pod: t.Name, Namespace: t.Namespace, Container: t.TargetContainers[index]}
+	if err := revertJournal.Remove(entry); err != nil {
+		log.Warnf("Unable to remove the chaos from the revert journal, err: %v", err)
+	}
+}
+
+// revertJournalEntry kills the leftover stress process group of the given journal entry
+func revertJournalEntry(entry journal.Entry) error {
+	pgid, err := strconv.Atoi(entry.Details["pgid"])
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Target: fmt.Sprintf("{podName: %s, namespace: %s, container: %s}", entry.Pod, entry.Namespace, entry.Container), Reason: fmt.Sprintf("invalid pgid inside the journal entry: %s", err.Error())}
+	}
+	startTime, err := processStartTime(pgid)
+	if err != nil || startTime != entry.Details["startTime"] {
+		// the stress process is already finished and the pid is either free or reused by another process
+		return nil
+	}
+	if err := syscall.Kill(-pgid, syscall.SIGKILL); err != nil && !strings.Contains(err.Error(), ProcessAlreadyKilled) {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosRevert, Target: fmt.Sprintf("{podName: %s, namespace: %s, container: %s}", entry.Pod, entry.Namespace, entry.Container), Reason: fmt.Sprintf("failed to kill the stress process: %s", err.Error())}
+	}
+	return nil
+}
+
+// processStartTime returns the start time of the given process, in clock ticks since the boot
+func processStartTime(pid int) (string, error) {
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return "", err
+	}
+	// the command name may contain spaces, the fields are parsed after it
+	fields := strings.Fields(string(stat[bytes.LastIndexByte(stat, ')')+1:]))
+	// the start time is the 22nd field, i.e, the 20th one after the command name
+	if len(fields) < 20 {
+		return "", fmt.Errorf("invalid stat of the %d process", pid)
+	}
+	return fields[19], nil
+}
+
 type targetDetails struct {
 	Name             string
 	Namespace        string
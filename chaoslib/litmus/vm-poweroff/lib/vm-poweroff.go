@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
@@ -33,12 +31,12 @@ func InjectVMPowerOffChaos(ctx context.Context, experimentsDetails *experimentTy
 	// inject channel is used to transmit signal notifications.
 	inject = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to inject channel.
-	signal.Notify(inject, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(inject)
 
 	// abort channel is used to transmit signal notifications.
 	abort = make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to abort channel.
-	signal.Notify(abort, os.Interrupt, syscall.SIGTERM)
+	common.NotifyAbort(abort)
 
 	//Waiting for the ramp time before chaos injection
 	if experimentsDetails.RampTime != 0 {
@@ -48,6 +46,9 @@ func InjectVMPowerOffChaos(ctx context.Context, experimentsDetails *experimentTy
 
 	//Fetching the target VM Ids
 	vmIdList := stringutils.SplitList(experimentsDetails.VMIds)
+	if err := common.CheckInstanceGuardrails("instances", vmIdList, 0, chaosDetails); err != nil {
+		return stacktrace.Propagate(err, "could not select target instances")
+	}
 
 	// Calling AbortWatcher go routine, it will continuously watch for the abort signal and generate the required events and result
 	go abortWatcher(experimentsDetails, vmIdList, clients, resultDetails, chaosDetails, eventsDetails, cookie)
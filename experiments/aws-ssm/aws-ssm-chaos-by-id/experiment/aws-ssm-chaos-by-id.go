@@ -2,196 +2,75 @@ package experiment
 
 import (
 	"context"
-	"os"
 
-	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
 	litmusLIB "github.com/litmuschaos/litmus-go/chaoslib/litmus/aws-ssm-chaos/lib/ssm"
 	experimentEnv "github.com/litmuschaos/litmus-go/pkg/aws-ssm/aws-ssm-chaos/environment"
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/aws-ssm/aws-ssm-chaos/types"
 	"github.com/litmuschaos/litmus-go/pkg/clients"
 	ec2 "github.com/litmuschaos/litmus-go/pkg/cloud/aws/ec2"
 	"github.com/litmuschaos/litmus-go/pkg/cloud/aws/ssm"
-	"github.com/litmuschaos/litmus-go/pkg/events"
+	"github.com/litmuschaos/litmus-go/pkg/lifecycle"
 	"github.com/litmuschaos/litmus-go/pkg/log"
-	"github.com/litmuschaos/litmus-go/pkg/probe"
-	"github.com/litmuschaos/litmus-go/pkg/result"
-	"github.com/litmuschaos/litmus-go/pkg/types"
-	"github.com/litmuschaos/litmus-go/pkg/utils/common"
+	"github.com/litmuschaos/litmus-go/pkg/registry"
 	"github.com/sirupsen/logrus"
 )
 
+func init() {
+	registry.RegisterExperiment("aws-ssm-chaos-by-id", "Runs the SSM document on the ec2 instances selected by id", AWSSSMChaosByID)
+	lifecycle.RegisterFault("aws-ssm-chaos-by-id", hooks)
+}
+
 // AWSSSMChaosByID inject the ssm chaos on ec2 instance
 func AWSSSMChaosByID(ctx context.Context, clients clients.ClientSets) {
+	lifecycle.Run(ctx, clients, hooks(clients))
+}
 
+// hooks returns the lifecycle hooks of the aws-ssm-chaos-by-id experiment
+func hooks(clients clients.ClientSets) lifecycle.Experiment {
 	experimentsDetails := experimentTypes.ExperimentDetails{}
-	resultDetails := types.ResultDetails{}
-	eventsDetails := types.EventDetails{}
-	chaosDetails := types.ChaosDetails{}
-
-	//Fetching all the ENV passed from the runner pod
-	log.Infof("[PreReq]: Getting the ENV for the %v experiment", os.Getenv("EXPERIMENT_NAME"))
-	experimentEnv.GetENV(&experimentsDetails, "aws-ssm-chaos-by-id")
-
-	// Initialize the chaos attributes
-	types.InitialiseChaosVariables(&chaosDetails)
-
-	// Initialize Chaos Result Parameters
-	types.SetResultAttributes(&resultDetails, chaosDetails)
 
-	if experimentsDetails.EngineName != "" {
-		// Get values from chaosengine. Bail out upon error, as we haven't entered exp business logic yet
-		if err := common.GetValuesFromChaosEngine(&chaosDetails, clients, &resultDetails); err != nil {
-			log.Errorf("Unable to initialize the probes: %v", err)
-			return
+	// verify the aws ec2 instance is running
+	instanceStatusCheck := func(ctx context.Context, state *lifecycle.State) error {
+		if !state.ChaosDetails.DefaultHealthCheck {
+			return nil
 		}
-	}
-
-	//Updating the chaos result in the beginning of experiment
-	log.Infof("[PreReq]: Updating the chaos result of %v experiment (SOT)", experimentsDetails.ExperimentName)
-	if err := result.ChaosResult(&chaosDetails, clients, &resultDetails, "SOT"); err != nil {
-		log.Errorf("Unable to create the chaosresult: %v", err)
-		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-		return
-	}
-
-	// Set the chaos result uid
-	result.SetResultUID(&resultDetails, clients, &chaosDetails)
-
-	// generating the event in chaosresult to marked the verdict as awaited
-	msg := "experiment: " + experimentsDetails.ExperimentName + ", Result: Awaited"
-	types.SetResultEventAttributes(&eventsDetails, types.AwaitedVerdict, msg, "Normal", &resultDetails)
-	if eventErr := events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosResult"); eventErr != nil {
-		log.Errorf("Failed to create %v event inside chaosresult", types.AwaitedVerdict)
-	}
-	// Calling AbortWatcher go routine, it will continuously watch for the abort signal and generate the required events and result
-	go common.AbortWatcherWithoutExit(experimentsDetails.ExperimentName, clients, &resultDetails, &chaosDetails, &eventsDetails)
-
-	//DISPLAY THE INSTANCE INFORMATION
-	log.InfoWithValues("The instance information is as follows", logrus.Fields{
-		"Total Chaos Duration": experimentsDetails.ChaosDuration,
-		"Chaos Namespace":      experimentsDetails.ChaosNamespace,
-		"Instance ID":          experimentsDetails.EC2InstanceID,
-		"Sequence":             experimentsDetails.Sequence,
-	})
-
-	if experimentsDetails.EngineName != "" {
-		// marking AUT as running, as we already checked the status of application under test
-		msg := "AUT: Running"
-
-		// run the probes in the pre-chaos check
-		if len(resultDetails.ProbeDetails) != 0 {
-
-			if err := probe.RunProbes(ctx, &chaosDetails, clients, &resultDetails, "PreChaos", &eventsDetails); err != nil {
-				log.Errorf("Probe Failed: %v", err)
-				msg := "AUT: Running, Probes: Unsuccessful"
-				types.SetEngineEventAttributes(&eventsDetails, types.PreChaosCheck, msg, "Warning", &chaosDetails)
-				if eventErr := events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosEngine"); eventErr != nil {
-					log.Errorf("Failed to create %v event inside chaosengine", types.PreChaosCheck)
-				}
-				result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-				return
-			}
-			msg = "AUT: Running, Probes: Successful"
-		}
-		// generating the events for the pre-chaos check
-		types.SetEngineEventAttributes(&eventsDetails, types.PreChaosCheck, msg, "Normal", &chaosDetails)
-		if eventErr := events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosEngine"); eventErr != nil {
-			log.Errorf("Failed to create %v event inside chaosengine", types.PreChaosCheck)
-		}
-	}
-
-	//Verify that the instance should have permission to perform ssm api calls
-	if err := ssm.CheckInstanceInformation(&experimentsDetails); err != nil {
-		log.Errorf("Failed perform ssm api calls: %v", err)
-		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-		return
-	}
-
-	if chaosDetails.DefaultHealthCheck {
-		//Verify the aws ec2 instance is running (pre chaos)
 		if err := ec2.InstanceStatusCheckByID(experimentsDetails.EC2InstanceID, experimentsDetails.Region); err != nil {
-			log.Errorf("Failed to get the ec2 instance status: %v", err)
-			result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-			return
+			return err
 		}
 		log.Info("[Status]: EC2 instance is in running state")
+		return nil
 	}
 
-	chaosDetails.Phase = types.ChaosInjectPhase
-
-	if err := litmusLIB.PrepareAWSSSMChaosByID(ctx, &experimentsDetails, clients, &resultDetails, &eventsDetails, &chaosDetails); err != nil {
-		log.Errorf("Chaos injection failed: %v", err)
-		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-		//Delete the ssm document on the given aws service monitoring docs
-		if experimentsDetails.IsDocsUploaded {
-			log.Info("[Recovery]: Delete the uploaded aws ssm docs")
-			if err := ssm.SSMDeleteDocument(experimentsDetails.DocumentName, experimentsDetails.Region); err != nil {
-				log.Errorf("Failed to delete ssm doc: %v", err)
+	return lifecycle.Experiment{
+		DryRunSupported:  true,
+		AbortWithoutExit: true,
+		GetENV:           func() error { return experimentEnv.GetENV(&experimentsDetails) },
+		Info: func(_ *lifecycle.State) logrus.Fields {
+			return logrus.Fields{
+				"Total Chaos Duration": experimentsDetails.ChaosDuration,
+				"Chaos Namespace":      experimentsDetails.ChaosNamespace,
+				"Instance ID":          experimentsDetails.EC2InstanceID,
+				"Sequence":             experimentsDetails.Sequence,
 			}
-		}
-		return
-	}
-
-	log.Infof("[Confirmation]: %v chaos has been injected successfully", experimentsDetails.ExperimentName)
-	resultDetails.Verdict = v1alpha1.ResultVerdictPassed
-
-	chaosDetails.Phase = types.PostChaosPhase
-
-	if chaosDetails.DefaultHealthCheck {
-		//Verify the aws ec2 instance is running (post chaos)
-		if err := ec2.InstanceStatusCheckByID(experimentsDetails.EC2InstanceID, experimentsDetails.Region); err != nil {
-			log.Errorf("Failed to get the ec2 instance status: %v", err)
-			result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-			return
-		}
-		log.Info("[Status]: EC2 instance is in running state (post chaos)")
-	}
-
-	if experimentsDetails.EngineName != "" {
-		// marking AUT as running, as we already checked the status of application under test
-		msg := "AUT: Running"
-
-		// run the probes in the post-chaos check
-		if len(resultDetails.ProbeDetails) != 0 {
-			if err := probe.RunProbes(ctx, &chaosDetails, clients, &resultDetails, "PostChaos", &eventsDetails); err != nil {
-				log.Errorf("Probes Failed: %v", err)
-				msg := "AUT: Running, Probes: Unsuccessful"
-				types.SetEngineEventAttributes(&eventsDetails, types.PostChaosCheck, msg, "Warning", &chaosDetails)
-				if eventErr := events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosEngine"); eventErr != nil {
-					log.Errorf("Failed to create %v event inside chaosengine", types.PostChaosCheck)
+		},
+		PreChaosCheck: func(ctx context.Context, state *lifecycle.State) error {
+			//Verify that the instance should have permission to perform ssm api calls
+			if err := ssm.CheckInstanceInformation(&experimentsDetails); err != nil {
+				return err
+			}
+			return instanceStatusCheck(ctx, state)
+		},
+		Inject: func(ctx context.Context, state *lifecycle.State) error {
+			err := litmusLIB.PrepareAWSSSMChaosByID(ctx, &experimentsDetails, clients, state.ResultDetails, state.EventsDetails, state.ChaosDetails)
+			//Delete the ssm document on the given aws service monitoring docs
+			if err != nil && experimentsDetails.IsDocsUploaded {
+				log.Info("[Recovery]: Delete the uploaded aws ssm docs")
+				if err := ssm.SSMDeleteDocument(experimentsDetails.DocumentName, experimentsDetails.Region); err != nil {
+					log.Errorf("Failed to delete ssm doc: %v", err)
 				}
-				result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-				return
 			}
-			msg = "AUT: Running, Probes: Successful"
-		}
-
-		// generating post chaos event
-		types.SetEngineEventAttributes(&eventsDetails, types.PostChaosCheck, msg, "Normal", &chaosDetails)
-		if eventErr := events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosEngine"); eventErr != nil {
-			log.Errorf("Failed to create %v event inside chaosengine", types.PostChaosCheck)
-		}
-	}
-
-	//Updating the chaosResult in the end of experiment
-	log.Infof("[The End]: Updating the chaos result of %v experiment (EOT)", experimentsDetails.ExperimentName)
-	if err := result.ChaosResult(&chaosDetails, clients, &resultDetails, "EOT"); err != nil {
-		log.Errorf("Unable to update the chaosresult:  %v", err)
-		return
-	}
-
-	// generating the event in chaosresult to marked the verdict as pass/fail
-	msg = "experiment: " + experimentsDetails.ExperimentName + ", Result: " + string(resultDetails.Verdict)
-	reason, eventType := types.GetChaosResultVerdictEvent(resultDetails.Verdict)
-	types.SetResultEventAttributes(&eventsDetails, reason, msg, eventType, &resultDetails)
-	if eventErr := events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosResult"); eventErr != nil {
-		log.Errorf("Failed to create %v event inside chaosresult", reason)
-	}
-	if experimentsDetails.EngineName != "" {
-		msg := experimentsDetails.ExperimentName + " experiment has been " + string(resultDetails.Verdict) + "ed"
-		types.SetEngineEventAttributes(&eventsDetails, types.Summary, msg, "Normal", &chaosDetails)
-		if eventErr := events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosEngine"); eventErr != nil {
-			log.Errorf("Failed to create %v event inside chaosengine", types.Summary)
-		}
+			return err
+		},
+		PostChaosCheck: instanceStatusCheck,
 	}
 }
@@ -2,218 +2,67 @@ package experiment
 
 import (
 	"context"
-	"os"
 
-	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
 	litmusLIB "github.com/litmuschaos/litmus-go/chaoslib/litmus/redfish-node-restart/lib"
 	redfishLib "github.com/litmuschaos/litmus-go/pkg/baremetal/redfish"
 	experimentEnv "github.com/litmuschaos/litmus-go/pkg/baremetal/redfish-node-restart/environment"
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/baremetal/redfish-node-restart/types"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
 	"github.com/litmuschaos/litmus-go/pkg/clients"
-	"github.com/litmuschaos/litmus-go/pkg/events"
+	"github.com/litmuschaos/litmus-go/pkg/lifecycle"
 	"github.com/litmuschaos/litmus-go/pkg/log"
-	"github.com/litmuschaos/litmus-go/pkg/probe"
-	"github.com/litmuschaos/litmus-go/pkg/result"
-	"github.com/litmuschaos/litmus-go/pkg/status"
-	"github.com/litmuschaos/litmus-go/pkg/types"
-	"github.com/litmuschaos/litmus-go/pkg/utils/common"
+	"github.com/litmuschaos/litmus-go/pkg/registry"
 	"github.com/sirupsen/logrus"
 )
 
+func init() {
+	registry.RegisterExperiment("redfish-node-restart", "Restarts the baremetal nodes using redfish", NodeRestart)
+	lifecycle.RegisterFault("redfish-node-restart", hooks)
+}
+
 // NodeRestart contains steps to inject chaos
 func NodeRestart(ctx context.Context, clients clients.ClientSets) {
+	lifecycle.Run(ctx, clients, hooks(clients))
+}
 
+// hooks returns the lifecycle hooks of the redfish-node-restart experiment
+func hooks(clients clients.ClientSets) lifecycle.Experiment {
 	experimentsDetails := experimentTypes.ExperimentDetails{}
-	resultDetails := types.ResultDetails{}
-	eventsDetails := types.EventDetails{}
-	chaosDetails := types.ChaosDetails{}
-
-	//Fetching all the ENV passed from the runner pod
-	log.Infof("[PreReq]: Getting the ENV for the %v experiment", os.Getenv("EXPERIMENT_NAME"))
-	experimentEnv.GetENV(&experimentsDetails)
-
-	// Initialize the chaos attributes
-	types.InitialiseChaosVariables(&chaosDetails)
-
-	// Initialize Chaos Result Parameters
-	types.SetResultAttributes(&resultDetails, chaosDetails)
 
-	if experimentsDetails.EngineName != "" {
-		// Get values from chaosengine. Bail out upon error, as we haven't entered exp business logic yet
-		if err := common.GetValuesFromChaosEngine(&chaosDetails, clients, &resultDetails); err != nil {
-			log.Errorf("Unable to initialize the probes, err: %v", err)
-			return
+	// verify the status of the application, auxiliary applications and node under test
+	checks := func(ctx context.Context, state *lifecycle.State) error {
+		if err := lifecycle.AUTStatusCheck(ctx, state); err != nil {
+			return err
 		}
-	}
-
-	//Updating the chaos result in the beginning of experiment
-	log.Infof("[PreReq]: Updating the chaos result of %v experiment (SOT)", experimentsDetails.ExperimentName)
-	if err := result.ChaosResult(&chaosDetails, clients, &resultDetails, "SOT"); err != nil {
-		log.Errorf("Unable to Create the Chaos Result, err: %v", err)
-		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-		return
-	}
-
-	// Set the chaos result uid
-	result.SetResultUID(&resultDetails, clients, &chaosDetails)
-
-	// generating the event in chaosresult to marked the verdict as awaited
-	msg := "experiment: " + experimentsDetails.ExperimentName + ", Result: Awaited"
-	types.SetResultEventAttributes(&eventsDetails, types.AwaitedVerdict, msg, "Normal", &resultDetails)
-	events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosResult")
-
-	//DISPLAY THE NODE INFORMATION
-	log.InfoWithValues("[Info]: The Node information is as follows", logrus.Fields{
-		"Node_IPMI_IP": experimentsDetails.IPMIIP,
-		"User":         experimentsDetails.User,
-	})
-
-	// Calling AbortWatcher go routine, it will continuously watch for the abort signal and generate the required events and result
-	go common.AbortWatcher(experimentsDetails.ExperimentName, clients, &resultDetails, &chaosDetails, &eventsDetails)
-
-	//PRE-CHAOS APPLICATION STATUS CHECK
-	if chaosDetails.DefaultHealthCheck {
-		log.Info("[Status]: Verify that the AUT (Application Under Test) is running (pre-chaos)")
-		if err := status.AUTStatusCheck(clients, &chaosDetails); err != nil {
-			log.Errorf("Application status check failed, err: %v", err)
-			result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-			return
+		if err := lifecycle.AuxiliaryAppStatusCheck(state, experimentsDetails.AuxiliaryAppInfo, experimentsDetails.Timeout, experimentsDetails.Delay); err != nil {
+			return err
 		}
-	}
 
-	//PRE-CHAOS AUXILIARY APPLICATION STATUS CHECK
-	if experimentsDetails.AuxiliaryAppInfo != "" {
-		log.Info("[Status]: Verify that the Auxiliary Applications are running (pre-chaos)")
-		if err := status.CheckAuxiliaryApplicationStatus(experimentsDetails.AuxiliaryAppInfo, experimentsDetails.Timeout, experimentsDetails.Delay, clients); err != nil {
-			log.Errorf("Auxiliary Application status check failed, err: %v", err)
-			result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-			return
+		log.Info("[Status]: Verify that the NUT (Node Under Test) is running")
+		nodeStatus, err := redfishLib.GetNodeStatus(experimentsDetails.IPMIIP, experimentsDetails.User, experimentsDetails.Password)
+		if err != nil {
+			return err
 		}
-	}
-
-	// PRE-CHAOS NODE STATUS CHECK
-	log.Info("[Status]: Verify that the NUT (Node Under Test) is running (pre-chaos)")
-	nodeStatus, err := redfishLib.GetNodeStatus(experimentsDetails.IPMIIP, experimentsDetails.User, experimentsDetails.Password)
-	if err != nil {
-		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-		log.Errorf("[Verification]: Unable to get node power status(pre-chaos). Error: %v", err)
-		return
-	}
-	if nodeStatus != "On" {
-		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-		log.Errorf("[Verification]: Node is not in running state(pre-chaos)")
-		return
-	}
-	log.Info("[Verification]: Node is in running state(pre-chaos)")
-
-	if experimentsDetails.EngineName != "" {
-		// marking NUT as running, as we already checked the status of node under test
-		msg := "NUT: Running"
-
-		// run the probes in the pre-chaos check
-		if len(resultDetails.ProbeDetails) != 0 {
-
-			if err := probe.RunProbes(ctx, &chaosDetails, clients, &resultDetails, "PreChaos", &eventsDetails); err != nil {
-				log.Errorf("Probe Failed, err: %v", err)
-				msg := "NUT: Running, Probes: Unsuccessful"
-				types.SetEngineEventAttributes(&eventsDetails, types.PreChaosCheck, msg, "Warning", &chaosDetails)
-				events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosEngine")
-				result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-				return
-			}
-			msg = "NUT: Running, Probes: Successful"
+		if nodeStatus != "On" {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeStatusChecks, Target: experimentsDetails.IPMIIP, Reason: "node is not in running state"}
 		}
-		// generating the events for the pre-chaos check
-		types.SetEngineEventAttributes(&eventsDetails, types.PreChaosCheck, msg, "Normal", &chaosDetails)
-		events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosEngine")
+		log.Info("[Verification]: Node is in running state")
+		return nil
 	}
 
-	chaosDetails.Phase = types.ChaosInjectPhase
-
-	if err := litmusLIB.PrepareChaos(ctx, &experimentsDetails, clients, &resultDetails, &eventsDetails, &chaosDetails); err != nil {
-		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-		log.Errorf("Chaos injection failed, err: %v", err)
-		return
-	}
-
-	log.Infof("[Confirmation]: %v chaos has been injected successfully", experimentsDetails.ExperimentName)
-	resultDetails.Verdict = v1alpha1.ResultVerdictPassed
-
-	chaosDetails.Phase = types.PostChaosPhase
-
-	//POST-CHAOS APPLICATION STATUS CHECK
-	if chaosDetails.DefaultHealthCheck {
-		log.Info("[Status]: Verify that the AUT (Application Under Test) is running (post-chaos)")
-		if err = status.AUTStatusCheck(clients, &chaosDetails); err != nil {
-			log.Errorf("Application status check failed, err: %v", err)
-			result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-			return
-		}
-	}
-
-	//POST-CHAOS AUXILIARY APPLICATION STATUS CHECK
-	if experimentsDetails.AuxiliaryAppInfo != "" {
-		log.Info("[Status]: Verify that the Auxiliary Applications are running (post-chaos)")
-		if err := status.CheckAuxiliaryApplicationStatus(experimentsDetails.AuxiliaryAppInfo, experimentsDetails.Timeout, experimentsDetails.Delay, clients); err != nil {
-			log.Errorf("Auxiliary Application status check failed, err: %v", err)
-			result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-			return
-		}
-	}
-
-	//POST-CHAOS NODE STATUS CHECK
-	log.Info("[Status]: Verify that the NUT (Node Under Test) is running (post-chaos)")
-	nodeStatus, err = redfishLib.GetNodeStatus(experimentsDetails.IPMIIP, experimentsDetails.User, experimentsDetails.Password)
-	if err != nil {
-		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-		log.Errorf("[Verification]: Unable to get node power status. Error: %v ", err)
-		return
-	}
-	if nodeStatus != "On" {
-		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-		log.Errorf("[Verification]: Node is not in running state(post-chaos)")
-		return
-	}
-	log.Info("[Verification]: Node is in running state(post-chaos)")
-
-	if experimentsDetails.EngineName != "" {
-		// marking NUT as running, as we already checked the status of application under test
-		msg := "NUT: Running"
-
-		// run the probes in the post-chaos check
-		if len(resultDetails.ProbeDetails) != 0 {
-			if err := probe.RunProbes(ctx, &chaosDetails, clients, &resultDetails, "PostChaos", &eventsDetails); err != nil {
-				log.Errorf("Probes Failed, err: %v", err)
-				msg := "NUT: Running, Probes: Unsuccessful"
-				types.SetEngineEventAttributes(&eventsDetails, types.PostChaosCheck, msg, "Warning", &chaosDetails)
-				events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosEngine")
-				result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
-				return
+	return lifecycle.Experiment{
+		Target: lifecycle.Target{Healthy: "NUT: Running", Unhealthy: "NUT: Not Running"},
+		GetENV: func() error { return experimentEnv.GetENV(&experimentsDetails) },
+		Info: func(_ *lifecycle.State) logrus.Fields {
+			return logrus.Fields{
+				"Node_IPMI_IP": experimentsDetails.IPMIIP,
+				"User":         experimentsDetails.User,
 			}
-			msg = "NUT: Running, Probes: Successful"
-		}
-
-		// generating post chaos event
-		types.SetEngineEventAttributes(&eventsDetails, types.PostChaosCheck, msg, "Normal", &chaosDetails)
-		events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosEngine")
-	}
-
-	//Updating the chaosResult in the end of experiment
-	log.Infof("[The End]: Updating the chaos result of %v experiment (EOT)", experimentsDetails.ExperimentName)
-	if err := result.ChaosResult(&chaosDetails, clients, &resultDetails, "EOT"); err != nil {
-		log.Errorf("Unable to Update the Chaos Result, err: %v", err)
-		return
-	}
-
-	// generating the event in chaosresult to marked the verdict as pass/fail
-	msg = "experiment: " + experimentsDetails.ExperimentName + ", Result: " + string(resultDetails.Verdict)
-	reason, eventType := types.GetChaosResultVerdictEvent(resultDetails.Verdict)
-	types.SetResultEventAttributes(&eventsDetails, reason, msg, eventType, &resultDetails)
-	events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosResult")
-
-	if experimentsDetails.EngineName != "" {
-		msg := experimentsDetails.ExperimentName + " experiment has been " + string(resultDetails.Verdict) + "ed"
-		types.SetEngineEventAttributes(&eventsDetails, types.Summary, msg, "Normal", &chaosDetails)
-		events.GenerateEvents(&eventsDetails, clients, &chaosDetails, "ChaosEngine")
+		},
+		PreChaosCheck: checks,
+		Inject: func(ctx context.Context, state *lifecycle.State) error {
+			return litmusLIB.PrepareChaos(ctx, &experimentsDetails, clients, state.ResultDetails, state.EventsDetails, state.ChaosDetails)
+		},
+		PostChaosCheck: checks,
 	}
 }
@@ -0,0 +1,19 @@
+package experiment
+
+import (
+	"context"
+	"os"
+
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/lifecycle"
+	"github.com/litmuschaos/litmus-go/pkg/registry"
+)
+
+func init() {
+	registry.RegisterExperiment("composite-chaos", "Injects multiple faults concurrently, under a single probe and result lifecycle", CompositeChaos)
+}
+
+// CompositeChaos inject the faults provided inside the FAULTS env concurrently
+func CompositeChaos(ctx context.Context, clients clients.ClientSets) {
+	lifecycle.Run(ctx, clients, lifecycle.Composite(clients, os.Getenv("FAULTS")))
+}
@@ -0,0 +1,59 @@
+// Package abort aborts the experiment from within, such as upon the failure of a probe with stopOnFailure
+package abort
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var (
+	mu     sync.Mutex
+	reason string
+
+	// ctx is cancelled once the experiment is aborted from within
+	ctx, cancel = context.WithCancel(context.Background())
+	// done is closed once the abort is handled, i.e. the chaosresult and the abort events are recorded
+	done     = make(chan struct{})
+	doneOnce sync.Once
+)
+
+// Trigger aborts the experiment with the given reason
+// it cancels the abort context, so that the abort watchers revert the chaos right away,
+// exactly as if the experiment is aborted by the chaosengine. Only the first trigger is recorded
+func Trigger(abortReason string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if reason != "" {
+		return
+	}
+	reason = abortReason
+	cancel()
+}
+
+// Reason returns the reason of the abort, it is empty unless the experiment is aborted from within
+func Reason() string {
+	mu.Lock()
+	defer mu.Unlock()
+	return reason
+}
+
+// Context returns the context, which is cancelled once the experiment is aborted from within
+func Context() context.Context {
+	return ctx
+}
+
+// Done marks the abort as handled
+func Done() {
+	doneOnce.Do(func() { close(done) })
+}
+
+// Wait waits for the abort to be handled, it returns false if the abort isn't handled within the given timeout
+func Wait(timeout time.Duration) bool {
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
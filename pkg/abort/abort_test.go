@@ -0,0 +1,26 @@
+package abort
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrigger(t *testing.T) {
+	assert.False(t, Wait(10*time.Millisecond))
+
+	Trigger("check-frontend probe has been failed")
+	Trigger("check-backend probe has been failed")
+
+	select {
+	case <-Context().Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("abort context is not cancelled")
+	}
+	assert.Equal(t, "check-frontend probe has been failed", Reason())
+
+	go Done()
+	assert.True(t, Wait(5*time.Second))
+	Done()
+}
@@ -0,0 +1,329 @@
+// Package alerts keeps the chaos away from the degraded systems, the chaos is not injected while the matching alerts are firing
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/litmuschaos/litmus-go/pkg/abort"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/httpauth"
+)
+
+// queryTimeout is the timeout of the requests to alertmanager and prometheus
+const queryTimeout = 10 * time.Second
+
+// orgIDHeader is the tenant header of the multi-tenant alert sources
+const orgIDHeader = "X-Scope-OrgID"
+
+// Alert is a firing alert, along with its labels
+type Alert struct {
+	Labels map[string]string `json:"labels"`
+}
+
+func (a Alert) String() string {
+	var labels []string
+	for name, value := range a.Labels {
+		if name != "alertname" {
+			labels = append(labels, fmt.Sprintf("%s=%q", name, value))
+		}
+	}
+	sort.Strings(labels)
+	return fmt.Sprintf("%s {%s}", a.Labels["alertname"], strings.Join(labels, ", "))
+}
+
+// matcher is a prometheus style label matcher, i.e, label=value, label!=value, label=~regex or label!~regex
+type matcher struct {
+	name  string
+	op    string
+	value string
+	re    *regexp.Regexp
+}
+
+// Check verifies that none of the matching alerts are firing, before the chaos injection
+// the gate is enabled once the alertmanager or the prometheus endpoint is provided
+func Check(ctx context.Context, chaosDetails *types.ChaosDetails) error {
+	if chaosDetails.AlertmanagerEndpoint == "" && chaosDetails.AlertPrometheusEndpoint == "" {
+		return nil
+	}
+	groups, err := parseMatchers(chaosDetails.AlertMatchers)
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: err.Error()}
+	}
+	c, err := newClient(chaosDetails)
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: err.Error()}
+	}
+
+	log.Info("[Status]: Verify that none of the matching alerts are firing")
+	alert, err := c.firingAlert(ctx, chaosDetails, groups)
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("failed to get the firing alerts: %s", err.Error())}
+	}
+	if alert != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeExperimentAborted, Reason: fmt.Sprintf("chaos is not allowed as the %s alert is firing", alert)}
+	}
+	return nil
+}
+
+// Watch keeps checking the firing alerts at the ALERT_CHECK_INTERVAL, until the context is cancelled
+// it aborts the experiment once a matching alert starts firing, so that the chaos is reverted
+// the failures to get the alerts are only logged, so that a flaky monitoring doesn't abort the experiment
+func Watch(ctx context.Context, chaosDetails *types.ChaosDetails) {
+	if chaosDetails.AlertmanagerEndpoint == "" && chaosDetails.AlertPrometheusEndpoint == "" {
+		return
+	}
+	groups, err := parseMatchers(chaosDetails.AlertMatchers)
+	if err != nil {
+		log.Errorf("Unable to watch the firing alerts, err: %v", err)
+		return
+	}
+	c, err := newClient(chaosDetails)
+	if err != nil {
+		log.Errorf("Unable to watch the firing alerts, err: %v", err)
+		return
+	}
+
+	ticker := time.NewTicker(time.Duration(chaosDetails.AlertCheckInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			alert, err := c.firingAlert(ctx, chaosDetails, groups)
+			if err != nil {
+				log.Warnf("Unable to get the firing alerts, err: %v", err)
+				continue
+			}
+			if alert != nil {
+				log.Errorf("[Abort]: The %v alert is firing, reverting the chaos", alert)
+				abort.Trigger(fmt.Sprintf("%s alert is firing", alert))
+				return
+			}
+		}
+	}
+}
+
+// client queries the alert sources with the credentials of the ALERT_* tunables
+type client struct {
+	http  *http.Client
+	auth  httpauth.Auth
+	orgID string
+}
+
+// newClient returns the client of the alert sources
+func newClient(chaosDetails *types.ChaosDetails) (*client, error) {
+	c := &client{
+		http:  &http.Client{},
+		auth:  httpauth.Auth{BearerTokenPath: chaosDetails.AlertBearerTokenPath},
+		orgID: chaosDetails.AlertOrgID,
+	}
+	if chaosDetails.AlertUsername != "" {
+		c.auth.BasicAuth = &httpauth.BasicAuth{Username: chaosDetails.AlertUsername, PasswordPath: chaosDetails.AlertPasswordPath}
+	}
+	if err := c.auth.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid alert credentials: %v", err)
+	}
+
+	tls := httpauth.TLS{
+		CACert:             chaosDetails.AlertCACert,
+		Cert:               chaosDetails.AlertCert,
+		Key:                chaosDetails.AlertKey,
+		InsecureSkipVerify: chaosDetails.AlertInsecureSkipVerify,
+	}
+	if tls != (httpauth.TLS{}) {
+		tlsConfig, err := tls.Config()
+		if err != nil {
+			return nil, fmt.Errorf("invalid alert tls: %v", err)
+		}
+		c.http.Transport = &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig}
+	}
+	return c, nil
+}
+
+// firingAlert returns the first firing alert, which matches any of the matcher groups
+func (c *client) firingAlert(ctx context.Context, chaosDetails *types.ChaosDetails, groups [][]matcher) (*Alert, error) {
+	var alerts []Alert
+	if chaosDetails.AlertmanagerEndpoint != "" {
+		firing, err := c.alertmanagerAlerts(ctx, chaosDetails.AlertmanagerEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, firing...)
+	}
+	if chaosDetails.AlertPrometheusEndpoint != "" {
+		firing, err := c.prometheusAlerts(ctx, chaosDetails.AlertPrometheusEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, firing...)
+	}
+
+	for i := range alerts {
+		if matches(groups, alerts[i].Labels) {
+			return &alerts[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// alertmanagerAlerts returns the active alerts of alertmanager, which are neither silenced nor inhibited
+func (c *client) alertmanagerAlerts(ctx context.Context, endpoint string) ([]Alert, error) {
+	query := url.Values{"active": {"true"}, "silenced": {"false"}, "inhibited": {"false"}}
+	var alerts []Alert
+	if err := c.getJSON(ctx, strings.TrimSuffix(endpoint, "/")+"/api/v2/alerts?"+query.Encode(), &alerts); err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+// prometheusAlerts returns the firing alerts of prometheus, derived from the ALERTS metric
+func (c *client) prometheusAlerts(ctx context.Context, endpoint string) ([]Alert, error) {
+	query := url.Values{"query": {`ALERTS{alertstate="firing"}`}}
+	var response struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			Result []struct {
+				Metric map[string]string `json:"metric"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := c.getJSON(ctx, strings.TrimSuffix(endpoint, "/")+"/api/v1/query?"+query.Encode(), &response); err != nil {
+		return nil, err
+	}
+	if response.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed: %s", response.Error)
+	}
+
+	var alerts []Alert
+	for _, result := range response.Data.Result {
+		delete(result.Metric, "__name__")
+		delete(result.Metric, "alertstate")
+		alerts = append(alerts, Alert{Labels: result.Metric})
+	}
+	return alerts, nil
+}
+
+// getJSON decodes the json response of the given url into out
+func (c *client) getJSON(ctx context.Context, url string, out interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if err := c.auth.Set(req); err != nil {
+		return err
+	}
+	if c.orgID != "" {
+		req.Header.Set(orgIDHeader, c.orgID)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s returned %s: %s", req.URL.Redacted(), resp.Status, strings.TrimSpace(string(body)))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// parseMatchers parses the semicolon separated groups of the comma separated matchers
+// an alert matches if it matches all the matchers of any of the groups
+func parseMatchers(s string) ([][]matcher, error) {
+	var groups [][]matcher
+	for _, group := range strings.Split(s, ";") {
+		var matchers []matcher
+		for _, expr := range strings.Split(group, ",") {
+			if strings.TrimSpace(expr) == "" {
+				continue
+			}
+			m, err := parseMatcher(expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ALERT_MATCHERS %q: %v", expr, err)
+			}
+			matchers = append(matchers, m)
+		}
+		if len(matchers) != 0 {
+			groups = append(groups, matchers)
+		}
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("ALERT_MATCHERS are required to gate the chaos on the firing alerts")
+	}
+	return groups, nil
+}
+
+func parseMatcher(expr string) (matcher, error) {
+	i := strings.IndexAny(expr, "=!")
+	if i == -1 || strings.TrimSpace(expr[:i]) == "" {
+		return matcher{}, fmt.Errorf("expected label=value, label!=value, label=~regex or label!~regex")
+	}
+	m := matcher{name: strings.TrimSpace(expr[:i])}
+	for _, op := range []string{"=~", "!~", "!=", "="} {
+		if strings.HasPrefix(expr[i:], op) {
+			m.op = op
+			break
+		}
+	}
+	if m.op == "" {
+		return matcher{}, fmt.Errorf("expected label=value, label!=value, label=~regex or label!~regex")
+	}
+	m.value = strings.Trim(strings.TrimSpace(expr[i+len(m.op):]), `"`)
+
+	if m.op == "=~" || m.op == "!~" {
+		// the regex is anchored at both the ends, as in prometheus
+		re, err := regexp.Compile("^(?:" + m.value + ")$")
+		if err != nil {
+			return matcher{}, err
+		}
+		m.re = re
+	}
+	return m, nil
+}
+
+// matches checks whether the labels match all the matchers of any of the groups
+func matches(groups [][]matcher, labels map[string]string) bool {
+	for _, group := range groups {
+		matched := true
+		for _, m := range group {
+			if !m.matches(labels[m.name]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (m matcher) matches(value string) bool {
+	switch m.op {
+	case "=":
+		return value == m.value
+	case "!=":
+		return value != m.value
+	case "=~":
+		return m.re.MatchString(value)
+	default:
+		return !m.re.MatchString(value)
+	}
+}
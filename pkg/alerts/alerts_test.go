@@ -0,0 +1,109 @@
+package alerts
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+func TestMatches(t *testing.T) {
+	labels := map[string]string{"alertname": "HighErrorRate", "severity": "warning", "service": "checkout"}
+
+	tests := []struct {
+		matchers string
+		want     bool
+	}{
+		{matchers: "severity=critical", want: false},
+		{matchers: "severity=critical;service=checkout", want: true},
+		{matchers: "severity=warning, service=cart", want: false},
+		{matchers: `severity=~"warning|critical", service!=cart`, want: true},
+		{matchers: "alertname!~High.*", want: false},
+		{matchers: "team!=payments", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.matchers, func(t *testing.T) {
+			groups, err := parseMatchers(tt.matchers)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, matches(groups, labels))
+		})
+	}
+}
+
+func TestParseMatchersInvalid(t *testing.T) {
+	for _, matchers := range []string{"", "severity", "=critical", "severity=~(critical"} {
+		_, err := parseMatchers(matchers)
+		assert.Error(t, err, matchers)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/alerts":
+			assert.Equal(t, "false", r.URL.Query().Get("silenced"))
+			w.Write([]byte(`[{"labels": {"alertname": "Watchdog", "severity": "none"}}]`))
+		case "/api/v1/query":
+			assert.Equal(t, `ALERTS{alertstate="firing"}`, r.URL.Query().Get("query"))
+			w.Write([]byte(`{"status": "success", "data": {"result": [{"metric": {"__name__": "ALERTS", "alertname": "KubePodCrashLooping", "severity": "critical"}}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		chaosDetails types.ChaosDetails
+		wantErr      cerrors.ErrorType
+	}{
+		{name: "disabled", chaosDetails: types.ChaosDetails{AlertMatchers: "severity=critical"}},
+		{name: "no matching alertmanager alert", chaosDetails: types.ChaosDetails{AlertmanagerEndpoint: server.URL, AlertMatchers: "severity=critical"}},
+		{name: "matching prometheus alert", chaosDetails: types.ChaosDetails{AlertPrometheusEndpoint: server.URL + "/", AlertMatchers: "severity=critical"}, wantErr: cerrors.ErrorTypeExperimentAborted},
+		{name: "failed request", chaosDetails: types.ChaosDetails{AlertmanagerEndpoint: server.URL + "/alertmanager", AlertMatchers: "severity=critical"}, wantErr: cerrors.ErrorTypeGeneric},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(context.Background(), &tt.chaosDetails)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Equal(t, tt.wantErr, err.(cerrors.Error).ErrorCode)
+		})
+	}
+}
+
+func TestCheckCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer t0ken" || r.Header.Get("X-Scope-OrgID") != "tenant-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("t0ken\n"), 0600))
+
+	chaosDetails := types.ChaosDetails{AlertmanagerEndpoint: server.URL, AlertMatchers: "severity=critical", AlertBearerTokenPath: tokenPath}
+	assert.Error(t, Check(context.Background(), &chaosDetails))
+
+	chaosDetails.AlertOrgID = "tenant-1"
+	assert.NoError(t, Check(context.Background(), &chaosDetails))
+
+	chaosDetails.AlertUsername = "admin"
+	assert.Error(t, Check(context.Background(), &chaosDetails))
+}
@@ -6,30 +6,30 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName       string
-	EngineName           string
-	RampTime             int
-	ChaosDuration        int
-	ChaosInterval        int
-	ChaosUID             clientTypes.UID
-	InstanceID           string
-	ChaosNamespace       string
-	ChaosPodName         string
-	Timeout              int
-	Delay                int
-	EC2InstanceID        string
-	EC2InstanceTag       string
-	Region               string
-	InstanceAffectedPerc int
-	Sequence             string
-	Cpu                  int
-	NumberOfWorkers      int
-	MemoryPercentage     int
-	InstallDependencies  string
-	DocumentName         string
-	DocumentType         string
-	DocumentFormat       string
-	DocumentPath         string
+	ExperimentName       string          `env:"EXPERIMENT_NAME"`
+	EngineName           string          `env:"CHAOSENGINE"`
+	RampTime             int             `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	ChaosDuration        int             `env:"TOTAL_CHAOS_DURATION" default:"60" unit:"s" min:"0"`
+	ChaosInterval        int             `env:"CHAOS_INTERVAL" default:"60" unit:"s" min:"0"`
+	ChaosUID             clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID           string          `env:"INSTANCE_ID"`
+	ChaosNamespace       string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName         string          `env:"POD_NAME"`
+	Timeout              int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	EC2InstanceID        string          `env:"EC2_INSTANCE_ID"`
+	EC2InstanceTag       string          `env:"EC2_INSTANCE_TAG"`
+	Region               string          `env:"REGION"`
+	InstanceAffectedPerc int             `env:"INSTANCE_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	Sequence             string          `env:"SEQUENCE" default:"parallel"`
+	Cpu                  int             `env:"CPU_CORE" default:"0" min:"0"`
+	NumberOfWorkers      int             `env:"NUMBER_OF_WORKERS" default:"1" min:"0"`
+	MemoryPercentage     int             `env:"MEMORY_PERCENTAGE" default:"80" min:"0" max:"100"`
+	InstallDependencies  string          `env:"INSTALL_DEPENDENCIES" default:"True"`
+	DocumentName         string          `env:"DOCUMENT_NAME" default:"LitmusChaos-AWS-SSM-Doc"`
+	DocumentType         string          `env:"DOCUMENT_TYPE" default:"Command"`
+	DocumentFormat       string          `env:"DOCUMENT_FORMAT" default:"YAML"`
+	DocumentPath         string          `env:"DOCUMENT_PATH" default:"LitmusChaos-AWS-SSM-Docs.yml"`
 	IsDocsUploaded       bool
 	CommandIDs           []string
 	TargetInstanceIDList []string
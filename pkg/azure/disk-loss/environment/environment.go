@@ -1,30 +1,19 @@
 package environment
 
 import (
-	"strconv"
 	"strings"
 
-	clientTypes "k8s.io/apimachinery/pkg/types"
-
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/azure/disk-loss/types"
 	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/tunables"
 )
 
 // GetENV fetches all the env variables from the runner pod
-func GetENV(experimentDetails *experimentTypes.ExperimentDetails) {
-	experimentDetails.ExperimentName = types.Getenv("EXPERIMENT_NAME", "azure-disk-loss")
-	experimentDetails.ChaosNamespace = types.Getenv("CHAOS_NAMESPACE", "litmus")
-	experimentDetails.EngineName = types.Getenv("CHAOSENGINE", "")
-	experimentDetails.ChaosDuration, _ = strconv.Atoi(types.Getenv("TOTAL_CHAOS_DURATION", "30"))
-	experimentDetails.ChaosInterval, _ = strconv.Atoi(types.Getenv("CHAOS_INTERVAL", "30"))
-	experimentDetails.RampTime, _ = strconv.Atoi(types.Getenv("RAMP_TIME", "0"))
-	experimentDetails.ChaosUID = clientTypes.UID(types.Getenv("CHAOS_UID", ""))
-	experimentDetails.InstanceID = types.Getenv("INSTANCE_ID", "")
-	experimentDetails.ChaosPodName = types.Getenv("POD_NAME", "")
-	experimentDetails.Delay, _ = strconv.Atoi(types.Getenv("STATUS_CHECK_DELAY", "2"))
-	experimentDetails.Timeout, _ = strconv.Atoi(types.Getenv("STATUS_CHECK_TIMEOUT", "180"))
-	experimentDetails.ScaleSet = types.Getenv("SCALE_SET", "disable")
-	experimentDetails.ResourceGroup = types.Getenv("RESOURCE_GROUP", "")
+func GetENV(experimentDetails *experimentTypes.ExperimentDetails) error {
+	if err := tunables.Load(experimentDetails, nil); err != nil {
+		return err
+	}
+
 	experimentDetails.VirtualDiskNames = strings.TrimSpace(types.Getenv("VIRTUAL_DISK_NAMES", ""))
-	experimentDetails.Sequence = types.Getenv("SEQUENCE", "parallel")
+	return nil
 }
@@ -1,29 +1,11 @@
 package environment
 
 import (
-	"strconv"
-
-	clientTypes "k8s.io/apimachinery/pkg/types"
-
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/baremetal/redfish-node-restart/types"
-	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/tunables"
 )
 
 // GetENV fetches all the env variables from the runner pod
-func GetENV(experimentDetails *experimentTypes.ExperimentDetails) {
-	experimentDetails.ExperimentName = types.Getenv("EXPERIMENT_NAME", "")
-	experimentDetails.ChaosNamespace = types.Getenv("CHAOS_NAMESPACE", "litmus")
-	experimentDetails.EngineName = types.Getenv("CHAOSENGINE", "")
-	experimentDetails.ChaosDuration, _ = strconv.Atoi(types.Getenv("TOTAL_CHAOS_DURATION", "30"))
-	experimentDetails.RampTime, _ = strconv.Atoi(types.Getenv("RAMP_TIME", "0"))
-	experimentDetails.TargetContainer = types.Getenv("TARGET_CONTAINER", "")
-	experimentDetails.ChaosUID = clientTypes.UID(types.Getenv("CHAOS_UID", ""))
-	experimentDetails.InstanceID = types.Getenv("INSTANCE_ID", "")
-	experimentDetails.ChaosPodName = types.Getenv("POD_NAME", "")
-	experimentDetails.AuxiliaryAppInfo = types.Getenv("AUXILIARY_APPINFO", "")
-	experimentDetails.Delay, _ = strconv.Atoi(types.Getenv("STATUS_CHECK_DELAY", "2"))
-	experimentDetails.Timeout, _ = strconv.Atoi(types.Getenv("STATUS_CHECK_TIMEOUT", "180"))
-	experimentDetails.IPMIIP = types.Getenv("IPMI_IP", "")
-	experimentDetails.User = types.Getenv("USER", "")
-	experimentDetails.Password = types.Getenv("PASSWORD", "")
+func GetENV(experimentDetails *experimentTypes.ExperimentDetails) error {
+	return tunables.Load(experimentDetails, nil)
 }
@@ -6,19 +6,19 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName   string
-	EngineName       string
-	ChaosDuration    int
-	RampTime         int
-	TargetContainer  string
-	ChaosUID         clientTypes.UID
-	InstanceID       string
-	ChaosNamespace   string
-	ChaosPodName     string
-	AuxiliaryAppInfo string
-	Timeout          int
-	Delay            int
-	IPMIIP           string
-	User             string
-	Password         string
+	ExperimentName   string          `env:"EXPERIMENT_NAME"`
+	EngineName       string          `env:"CHAOSENGINE"`
+	ChaosDuration    int             `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	RampTime         int             `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	TargetContainer  string          `env:"TARGET_CONTAINER"`
+	ChaosUID         clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID       string          `env:"INSTANCE_ID"`
+	ChaosNamespace   string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName     string          `env:"POD_NAME"`
+	AuxiliaryAppInfo string          `env:"AUXILIARY_APPINFO"`
+	Timeout          int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay            int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	IPMIIP           string          `env:"IPMI_IP"`
+	User             string          `env:"USER"`
+	Password         string          `env:"PASSWORD"`
 }
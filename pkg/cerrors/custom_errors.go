@@ -28,6 +28,14 @@ const (
 	FailureTypeHttpProbe       ErrorType = "HTTP_PROBE_FAILURE"
 	ErrorTypePromProbe         ErrorType = "PROM_PROBE_ERROR"
 	FailureTypePromProbe       ErrorType = "PROM_PROBE_FAILURE"
+	ErrorTypeGRPCProbe         ErrorType = "GRPC_PROBE_ERROR"
+	FailureTypeGRPCProbe       ErrorType = "GRPC_PROBE_FAILURE"
+	ErrorTypeNetProbe          ErrorType = "NET_PROBE_ERROR"
+	FailureTypeNetProbe        ErrorType = "NET_PROBE_FAILURE"
+	ErrorTypeDNSProbe          ErrorType = "DNS_PROBE_ERROR"
+	FailureTypeDNSProbe        ErrorType = "DNS_PROBE_FAILURE"
+	ErrorTypeLogProbe          ErrorType = "LOG_PROBE_ERROR"
+	FailureTypeLogProbe        ErrorType = "LOG_PROBE_FAILURE"
 	ErrorTypeTimeout           ErrorType = "TIMEOUT"
 	FailureTypeProbeTimeout    ErrorType = "PROBE_TIMEOUT"
 )
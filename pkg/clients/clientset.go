@@ -13,6 +13,10 @@ import (
 	"k8s.io/klog"
 )
 
+// kubeconfig is defined at package level so that the binaries can parse
+// their own flags before the clientSets are generated
+var kubeconfig = flag.String("kubeconfig", "", "absolute path to the kubeconfig file")
+
 // ClientSets is a collection of clientSets and kubeConfig needed
 type ClientSets struct {
 	KubeClient    *kubernetes.Clientset
@@ -49,8 +53,9 @@ func (clientSets *ClientSets) GenerateClientSetFromKubeConfig() error {
 
 // getKubeConfig setup the config for access cluster resource
 func getKubeConfig() (*rest.Config, error) {
-	kubeconfig := flag.String("kubeconfig", "", "absolute path to the kubeconfig file")
-	flag.Parse()
+	if !flag.Parsed() {
+		flag.Parse()
+	}
 	// It uses in-cluster config, if kubeconfig path is not specified
 	config, err := buildConfigFromFlags("", *kubeconfig)
 	return config, err
@@ -6,6 +6,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	core_v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/litmuschaos/litmus-go/pkg/types"
@@ -125,6 +126,20 @@ func (clients *ClientSets) CreateService(namespace string, svc *core_v1.Service)
 		})
 }
 
+// ApplyConfigMap creates the given configmap or updates it, if already present
+func (clients *ClientSets) ApplyConfigMap(namespace string, configMap *core_v1.ConfigMap) error {
+	return retry.
+		Times(uint(defaultTimeout / defaultDelay)).
+		Wait(time.Duration(defaultDelay) * time.Second).
+		Try(func(attempt uint) error {
+			_, err := clients.KubeClient.CoreV1().ConfigMaps(namespace).Create(context.Background(), configMap, v1.CreateOptions{})
+			if k8serrors.IsAlreadyExists(err) {
+				_, err = clients.KubeClient.CoreV1().ConfigMaps(namespace).Update(context.Background(), configMap, v1.UpdateOptions{})
+			}
+			return err
+		})
+}
+
 func (clients *ClientSets) GetNode(name string, timeout, delay int) (*core_v1.Node, error) {
 	var (
 		node *core_v1.Node
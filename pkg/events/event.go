@@ -43,6 +43,10 @@ func CreateEvents(eventsDetails *types.EventDetails, clients clients.ClientSets,
 // GenerateEvents update the events and increase the count by 1, if already present
 // else it will create a new event
 func GenerateEvents(eventsDetails *types.EventDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails, kind string) error {
+	// the chaos resources don't exist in standalone mode, so there is no object to attach the events to
+	if chaosDetails.ResultFile != "" {
+		return nil
+	}
 
 	switch kind {
 	case "ChaosResult":
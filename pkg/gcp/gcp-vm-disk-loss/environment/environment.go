@@ -1,32 +1,11 @@
 package environment
 
 import (
-	"strconv"
-
-	clientTypes "k8s.io/apimachinery/pkg/types"
-
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/gcp/gcp-vm-disk-loss/types"
-	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/tunables"
 )
 
 // GetENV fetches all the env variables from the runner pod
-func GetENV(experimentDetails *experimentTypes.ExperimentDetails) {
-	experimentDetails.ExperimentName = types.Getenv("EXPERIMENT_NAME", "")
-	experimentDetails.ChaosNamespace = types.Getenv("CHAOS_NAMESPACE", "litmus")
-	experimentDetails.EngineName = types.Getenv("CHAOSENGINE", "")
-	experimentDetails.ChaosDuration, _ = strconv.Atoi(types.Getenv("TOTAL_CHAOS_DURATION", "30"))
-	experimentDetails.ChaosInterval, _ = strconv.Atoi(types.Getenv("CHAOS_INTERVAL", "30"))
-	experimentDetails.RampTime, _ = strconv.Atoi(types.Getenv("RAMP_TIME", "0"))
-	experimentDetails.ChaosUID = clientTypes.UID(types.Getenv("CHAOS_UID", ""))
-	experimentDetails.InstanceID = types.Getenv("INSTANCE_ID", "")
-	experimentDetails.ChaosPodName = types.Getenv("POD_NAME", "")
-	experimentDetails.Delay, _ = strconv.Atoi(types.Getenv("STATUS_CHECK_DELAY", "2"))
-	experimentDetails.Timeout, _ = strconv.Atoi(types.Getenv("STATUS_CHECK_TIMEOUT", "180"))
-	experimentDetails.TargetContainer = types.Getenv("TARGET_CONTAINER", "")
-	experimentDetails.GCPProjectID = types.Getenv("GCP_PROJECT_ID", "")
-	experimentDetails.DiskVolumeNames = types.Getenv("DISK_VOLUME_NAMES", "")
-	experimentDetails.DiskVolumeLabel = types.Getenv("DISK_VOLUME_LABEL", "")
-	experimentDetails.Sequence = types.Getenv("SEQUENCE", "parallel")
-	experimentDetails.Zones = types.Getenv("ZONES", "")
-	experimentDetails.DiskAffectedPerc, _ = strconv.Atoi(types.Getenv("DISK_AFFECTED_PERC", "0"))
+func GetENV(experimentDetails *experimentTypes.ExperimentDetails) error {
+	return tunables.Load(experimentDetails, nil)
 }
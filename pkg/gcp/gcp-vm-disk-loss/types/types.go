@@ -6,25 +6,25 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName              string
-	EngineName                  string
-	ChaosDuration               int
-	ChaosInterval               int
-	RampTime                    int
-	ChaosUID                    clientTypes.UID
-	InstanceID                  string
-	ChaosNamespace              string
-	ChaosPodName                string
-	Timeout                     int
-	Delay                       int
-	Sequence                    string
-	TargetContainer             string
-	GCPProjectID                string
-	DiskVolumeNames             string
-	Zones                       string
-	DiskVolumeLabel             string
+	ExperimentName              string          `env:"EXPERIMENT_NAME"`
+	EngineName                  string          `env:"CHAOSENGINE"`
+	ChaosDuration               int             `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	ChaosInterval               int             `env:"CHAOS_INTERVAL" default:"30" unit:"s" min:"0"`
+	RampTime                    int             `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	ChaosUID                    clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID                  string          `env:"INSTANCE_ID"`
+	ChaosNamespace              string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName                string          `env:"POD_NAME"`
+	Timeout                     int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                       int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	Sequence                    string          `env:"SEQUENCE" default:"parallel"`
+	TargetContainer             string          `env:"TARGET_CONTAINER"`
+	GCPProjectID                string          `env:"GCP_PROJECT_ID"`
+	DiskVolumeNames             string          `env:"DISK_VOLUME_NAMES"`
+	Zones                       string          `env:"ZONES"`
+	DiskVolumeLabel             string          `env:"DISK_VOLUME_LABEL"`
 	TargetDiskVolumeNamesList   []string
 	TargetDiskInstanceNamesList []string
-	DiskAffectedPerc            int
+	DiskAffectedPerc            int `env:"DISK_AFFECTED_PERC" default:"0" min:"0" max:"100"`
 	DeviceNamesList             []string
 }
@@ -6,24 +6,24 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName           string
-	EngineName               string
-	ChaosDuration            int
-	ChaosInterval            int
-	RampTime                 int
-	ChaosUID                 clientTypes.UID
-	InstanceID               string
-	ChaosNamespace           string
-	ChaosPodName             string
-	Timeout                  int
-	Delay                    int
-	VMInstanceName           string
-	GCPProjectID             string
-	Zones                    string
-	ManagedInstanceGroup     string
-	Sequence                 string
-	TargetContainer          string
-	InstanceLabel            string
-	InstanceAffectedPerc     int
+	ExperimentName           string          `env:"EXPERIMENT_NAME"`
+	EngineName               string          `env:"CHAOSENGINE"`
+	ChaosDuration            int             `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	ChaosInterval            int             `env:"CHAOS_INTERVAL" default:"30" unit:"s" min:"0"`
+	RampTime                 int             `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	ChaosUID                 clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID               string          `env:"INSTANCE_ID"`
+	ChaosNamespace           string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName             string          `env:"POD_NAME"`
+	Timeout                  int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                    int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	VMInstanceName           string          `env:"VM_INSTANCE_NAMES"`
+	GCPProjectID             string          `env:"GCP_PROJECT_ID"`
+	Zones                    string          `env:"ZONES"`
+	ManagedInstanceGroup     string          `env:"MANAGED_INSTANCE_GROUP" default:"disable"`
+	Sequence                 string          `env:"SEQUENCE" default:"parallel"`
+	TargetContainer          string          `env:"TARGET_CONTAINER"`
+	InstanceLabel            string          `env:"INSTANCE_LABEL"`
+	InstanceAffectedPerc     int             `env:"INSTANCE_AFFECTED_PERC" default:"0" min:"0" max:"100"`
 	TargetVMInstanceNameList []string
 }
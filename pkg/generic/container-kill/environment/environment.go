@@ -1,41 +1,11 @@
 package environment
 
 import (
-	"strconv"
-
-	clientTypes "k8s.io/apimachinery/pkg/types"
-
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/generic/container-kill/types"
-	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/tunables"
 )
 
 // GetENV fetches all the env variables from the runner pod
-func GetENV(experimentDetails *experimentTypes.ExperimentDetails) {
-	experimentDetails.ExperimentName = types.Getenv("EXPERIMENT_NAME", "container-kill")
-	experimentDetails.ChaosNamespace = types.Getenv("CHAOS_NAMESPACE", "")
-	experimentDetails.EngineName = types.Getenv("CHAOSENGINE", "")
-	experimentDetails.ChaosDuration, _ = strconv.Atoi(types.Getenv("TOTAL_CHAOS_DURATION", "20"))
-	experimentDetails.ChaosInterval, _ = strconv.Atoi(types.Getenv("CHAOS_INTERVAL", "10"))
-	experimentDetails.RampTime, _ = strconv.Atoi(types.Getenv("RAMP_TIME", "0"))
-	experimentDetails.ChaosUID = clientTypes.UID(types.Getenv("CHAOS_UID", ""))
-	experimentDetails.InstanceID = types.Getenv("INSTANCE_ID", "")
-	experimentDetails.ChaosPodName = types.Getenv("POD_NAME", "")
-	experimentDetails.ChaosServiceAccount = types.Getenv("CHAOS_SERVICE_ACCOUNT", "")
-	experimentDetails.LIBImage = types.Getenv("LIB_IMAGE", "litmuschaos/go-runner:latest")
-	experimentDetails.LIBImagePullPolicy = types.Getenv("LIB_IMAGE_PULL_POLICY", "Always")
-	experimentDetails.TargetContainer = types.Getenv("TARGET_CONTAINER", "")
-	experimentDetails.SocketPath = types.Getenv("SOCKET_PATH", "/run/containerd/containerd.sock")
-	experimentDetails.ContainerAPITimeout, _ = strconv.Atoi(types.Getenv("CONTAINER_API_TIMEOUT", "-1"))
-	experimentDetails.PodsAffectedPerc = types.Getenv("PODS_AFFECTED_PERC", "0")
-	experimentDetails.Delay, _ = strconv.Atoi(types.Getenv("STATUS_CHECK_DELAY", "2"))
-	experimentDetails.Timeout, _ = strconv.Atoi(types.Getenv("STATUS_CHECK_TIMEOUT", "180"))
-	experimentDetails.TargetPods = types.Getenv("TARGET_PODS", "")
-	experimentDetails.ContainerRuntime = types.Getenv("CONTAINER_RUNTIME", "containerd")
-	experimentDetails.Sequence = types.Getenv("SEQUENCE", "parallel")
-	experimentDetails.Signal = types.Getenv("SIGNAL", "SIGKILL")
-	experimentDetails.TerminationGracePeriodSeconds, _ = strconv.Atoi(types.Getenv("TERMINATION_GRACE_PERIOD_SECONDS", ""))
-	experimentDetails.NodeLabel = types.Getenv("NODE_LABEL", "")
-	experimentDetails.SetHelperData = types.Getenv("SET_HELPER_DATA", "true")
-	experimentDetails.PodTerminationOrder = types.Getenv("POD_TERMINATION_ORDER", "random")
-	experimentDetails.InterPodKillIntervalSeconds, _ = strconv.Atoi(types.Getenv("INTER_POD_KILL_INTERVAL_SECONDS", "0"))
+func GetENV(experimentDetails *experimentTypes.ExperimentDetails) error {
+	return tunables.Load(experimentDetails, nil)
 }
@@ -6,36 +6,36 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName                string
-	EngineName                    string
-	ChaosDuration                 int
-	ChaosInterval                 int
-	RampTime                      int
+	ExperimentName                string `env:"EXPERIMENT_NAME" default:"container-kill"`
+	EngineName                    string `env:"CHAOSENGINE"`
+	ChaosDuration                 int    `env:"TOTAL_CHAOS_DURATION" default:"20" unit:"s" min:"0"`
+	ChaosInterval                 int    `env:"CHAOS_INTERVAL" default:"10" unit:"s" min:"0"`
+	RampTime                      int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
 	AppNS                         string
 	AppLabel                      string
 	AppKind                       string
-	ChaosUID                      clientTypes.UID
-	TerminationGracePeriodSeconds int
-	InstanceID                    string
-	ChaosNamespace                string
-	ChaosPodName                  string
-	LIBImage                      string
-	LIBImagePullPolicy            string
-	TargetContainer               string
-	SocketPath                    string
-	ContainerAPITimeout           int
-	ChaosServiceAccount           string
+	ChaosUID                      clientTypes.UID `env:"CHAOS_UID"`
+	TerminationGracePeriodSeconds int             `env:"TERMINATION_GRACE_PERIOD_SECONDS" unit:"s" min:"0"`
+	InstanceID                    string          `env:"INSTANCE_ID"`
+	ChaosNamespace                string          `env:"CHAOS_NAMESPACE"`
+	ChaosPodName                  string          `env:"POD_NAME"`
+	LIBImage                      string          `env:"LIB_IMAGE" default:"litmuschaos/go-runner:latest"`
+	LIBImagePullPolicy            string          `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	TargetContainer               string          `env:"TARGET_CONTAINER"`
+	SocketPath                    string          `env:"SOCKET_PATH" default:"/run/containerd/containerd.sock"`
+	ContainerAPITimeout           int             `env:"CONTAINER_API_TIMEOUT" default:"-1"`
+	ChaosServiceAccount           string          `env:"CHAOS_SERVICE_ACCOUNT"`
 	RunID                         string
-	Timeout                       int
-	Delay                         int
-	TargetPods                    string
-	ContainerRuntime              string
-	PodsAffectedPerc              string
-	Sequence                      string
-	Signal                        string
-	NodeLabel                     string
+	Timeout                       int    `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                         int    `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TargetPods                    string `env:"TARGET_PODS"`
+	ContainerRuntime              string `env:"CONTAINER_RUNTIME" default:"containerd"`
+	PodsAffectedPerc              string `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	Sequence                      string `env:"SEQUENCE" default:"parallel"`
+	Signal                        string `env:"SIGNAL" default:"SIGKILL"`
+	NodeLabel                     string `env:"NODE_LABEL"`
 	IsTargetContainerProvided     bool
-	SetHelperData                 string
-	PodTerminationOrder           string
-	InterPodKillIntervalSeconds   int
+	SetHelperData                 string `env:"SET_HELPER_DATA" default:"true"`
+	PodTerminationOrder           string `env:"POD_TERMINATION_ORDER" default:"random"`
+	InterPodKillIntervalSeconds   int    `env:"INTER_POD_KILL_INTERVAL_SECONDS" default:"0" unit:"s" min:"0"`
 }
@@ -6,34 +6,34 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName                string
-	EngineName                    string
-	ChaosDuration                 int
-	RampTime                      int
+	ExperimentName                string `env:"EXPERIMENT_NAME" default:"disk-fill"`
+	EngineName                    string `env:"CHAOSENGINE"`
+	ChaosDuration                 int    `env:"TOTAL_CHAOS_DURATION" default:"60" unit:"s" min:"0"`
+	RampTime                      int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
 	AppNS                         string
 	AppLabel                      string
 	AppKind                       string
-	ChaosUID                      clientTypes.UID
-	InstanceID                    string
-	ChaosNamespace                string
-	ChaosPodName                  string
-	TargetContainer               string
-	FillPercentage                string
-	ContainerRuntime              string
-	SocketPath                    string
+	ChaosUID                      clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID                    string          `env:"INSTANCE_ID"`
+	ChaosNamespace                string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName                  string          `env:"POD_NAME"`
+	TargetContainer               string          `env:"TARGET_CONTAINER"`
+	FillPercentage                string          `env:"FILL_PERCENTAGE" default:"80"`
+	ContainerRuntime              string          `env:"CONTAINER_RUNTIME" default:"containerd"`
+	SocketPath                    string          `env:"SOCKET_PATH" default:"/run/containerd/containerd.sock"`
 	RunID                         string
-	Timeout                       int
-	Delay                         int
-	LIBImage                      string
-	LIBImagePullPolicy            string
-	TargetPods                    string
-	PodsAffectedPerc              string
-	Sequence                      string
+	Timeout                       int    `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                         int    `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	LIBImage                      string `env:"LIB_IMAGE" default:"litmuschaos/go-runner:latest"`
+	LIBImagePullPolicy            string `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	TargetPods                    string `env:"TARGET_PODS"`
+	PodsAffectedPerc              string `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	Sequence                      string `env:"SEQUENCE" default:"parallel"`
 	ChaosServiceAccount           string
-	EphemeralStorageMebibytes     string
-	TerminationGracePeriodSeconds int
-	DataBlockSize                 int
-	NodeLabel                     string
+	EphemeralStorageMebibytes     string `env:"EPHEMERAL_STORAGE_MEBIBYTES"`
+	TerminationGracePeriodSeconds int    `env:"TERMINATION_GRACE_PERIOD_SECONDS" unit:"s" min:"0"`
+	DataBlockSize                 int    `env:"DATA_BLOCK_SIZE" default:"256" min:"0"`
+	NodeLabel                     string `env:"NODE_LABEL"`
 	IsTargetContainerProvided     bool
-	SetHelperData                 string
+	SetHelperData                 string `env:"SET_HELPER_DATA" default:"true"`
 }
@@ -6,26 +6,26 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName                string
-	EngineName                    string
-	ChaosDuration                 int
-	RampTime                      int
+	ExperimentName                string `env:"EXPERIMENT_NAME" default:"docker-service-kill"`
+	EngineName                    string `env:"CHAOSENGINE"`
+	ChaosDuration                 int    `env:"TOTAL_CHAOS_DURATION" default:"90" unit:"s" min:"0"`
+	RampTime                      int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
 	AppNS                         string
 	AppLabel                      string
 	AppKind                       string
-	ChaosUID                      clientTypes.UID
-	TerminationGracePeriodSeconds int
-	InstanceID                    string
-	ChaosNamespace                string
-	ChaosPodName                  string
-	AuxiliaryAppInfo              string
+	ChaosUID                      clientTypes.UID `env:"CHAOS_UID"`
+	TerminationGracePeriodSeconds int             `env:"TERMINATION_GRACE_PERIOD_SECONDS" unit:"s" min:"0"`
+	InstanceID                    string          `env:"INSTANCE_ID"`
+	ChaosNamespace                string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName                  string          `env:"POD_NAME"`
+	AuxiliaryAppInfo              string          `env:"AUXILIARY_APPINFO"`
 	RunID                         string
-	TargetNode                    string
-	NodeLabel                     string
-	Timeout                       int
-	Delay                         int
-	LIBImage                      string
-	LIBImagePullPolicy            string
-	TargetContainer               string
-	SetHelperData                 string
+	TargetNode                    string `env:"TARGET_NODE"`
+	NodeLabel                     string `env:"NODE_LABEL"`
+	Timeout                       int    `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                         int    `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	LIBImage                      string `env:"LIB_IMAGE" default:"ubuntu:16.04"`
+	LIBImagePullPolicy            string `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	TargetContainer               string `env:"TARGET_CONTAINER"`
+	SetHelperData                 string `env:"SET_HELPER_DATA" default:"true"`
 }
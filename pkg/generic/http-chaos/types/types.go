@@ -6,46 +6,46 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName                string
-	EngineName                    string
-	ChaosDuration                 int
-	LIBImage                      string
-	LIBImagePullPolicy            string
-	RampTime                      int
+	ExperimentName                string `env:"EXPERIMENT_NAME"`
+	EngineName                    string `env:"CHAOSENGINE"`
+	ChaosDuration                 int    `env:"TOTAL_CHAOS_DURATION" default:"60" unit:"s" min:"0"`
+	LIBImage                      string `env:"LIB_IMAGE" default:"litmuschaos/go-runner:latest"`
+	LIBImagePullPolicy            string `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	RampTime                      int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
 	AppNS                         string
 	AppLabel                      string
 	AppKind                       string
-	ChaosUID                      clientTypes.UID
-	InstanceID                    string
-	ChaosNamespace                string
-	ChaosPodName                  string
+	ChaosUID                      clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID                    string          `env:"INSTANCE_ID"`
+	ChaosNamespace                string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName                  string          `env:"POD_NAME"`
 	RunID                         string
-	TargetContainer               string
+	TargetContainer               string `env:"TARGET_CONTAINER"`
 	IsTargetContainerProvided     bool
-	Timeout                       int
-	Delay                         int
-	TerminationGracePeriodSeconds int
-	TargetPods                    string
-	PodsAffectedPerc              string
-	ContainerRuntime              string
-	ChaosServiceAccount           string
-	SocketPath                    string
-	SetHelperData                 string
-	Sequence                      string
-	NodeLabel                     string
+	Timeout                       int    `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                         int    `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TerminationGracePeriodSeconds int    `env:"TERMINATION_GRACE_PERIOD_SECONDS" unit:"s" min:"0"`
+	TargetPods                    string `env:"TARGET_PODS"`
+	PodsAffectedPerc              string `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	ContainerRuntime              string `env:"CONTAINER_RUNTIME" default:"containerd"`
+	ChaosServiceAccount           string `env:"CHAOS_SERVICE_ACCOUNT"`
+	SocketPath                    string `env:"SOCKET_PATH" default:"/run/containerd/containerd.sock"`
+	SetHelperData                 string `env:"SET_HELPER_DATA" default:"true"`
+	Sequence                      string `env:"SEQUENCE" default:"parallel"`
+	NodeLabel                     string `env:"NODE_LABEL"`
 
-	NetworkInterface  string
-	TargetServicePort int
-	Toxicity          int
-	ProxyPort         int
+	NetworkInterface  string `env:"NETWORK_INTERFACE" default:"eth0"`
+	TargetServicePort int    `env:"TARGET_SERVICE_PORT" default:"80" min:"0" max:"65535"`
+	Toxicity          int    `env:"TOXICITY" default:"100" min:"0" max:"100"`
+	ProxyPort         int    `env:"PROXY_PORT" default:"20000" min:"0" max:"65535"`
 
-	Latency            int
-	ResetTimeout       int
-	StatusCode         string
-	ModifyResponseBody string
-	HeadersMap         string
-	HeaderMode         string
-	ResponseBody       string
-	ContentType        string
-	ContentEncoding    string
+	Latency            int    `env:"LATENCY" default:"6000" unit:"ms" min:"0"`
+	ResetTimeout       int    `env:"RESET_TIMEOUT" default:"0" unit:"ms" min:"0"`
+	StatusCode         string `env:"STATUS_CODE"`
+	ModifyResponseBody string `env:"MODIFY_RESPONSE_BODY" default:"true"`
+	HeadersMap         string `env:"HEADERS_MAP" default:"{}"`
+	HeaderMode         string `env:"HEADER_MODE" default:"response"`
+	ResponseBody       string `env:"RESPONSE_BODY"`
+	ContentType        string `env:"CONTENT_TYPE" default:"text/plain"`
+	ContentEncoding    string `env:"CONTENT_ENCODING"`
 }
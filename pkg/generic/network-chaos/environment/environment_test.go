@@ -0,0 +1,36 @@
+package environment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	experimentTypes "github.com/litmuschaos/litmus-go/pkg/generic/network-chaos/types"
+)
+
+func TestGetENV(t *testing.T) {
+	t.Setenv("NETWORK_LATENCY", "slow")
+	t.Setenv("CORRELATION", "200")
+	t.Setenv("NETWORK_PACKET_LOSS_PERCENTAGE", "50")
+
+	// the invalid latency tunables aren't read by the rate limit
+	details := experimentTypes.ExperimentDetails{}
+	require.NoError(t, GetENV(&details, "pod-network-rate-limit"))
+	assert.Equal(t, "network-rate-limit", details.NetworkChaosType)
+	assert.Equal(t, "1mbit", details.NetworkBandwidth)
+
+	// the loss shares the correlation with the other netem experiments
+	details = experimentTypes.ExperimentDetails{}
+	err := GetENV(&details, "pod-network-loss")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "CORRELATION")
+	assert.NotContains(t, err.Error(), "NETWORK_LATENCY")
+	assert.Equal(t, "50", details.NetworkPacketLossPercentage)
+
+	details = experimentTypes.ExperimentDetails{}
+	err = GetENV(&details, "pod-network-latency")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "NETWORK_LATENCY")
+	assert.Contains(t, err.Error(), "CORRELATION")
+}
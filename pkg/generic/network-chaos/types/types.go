@@ -6,48 +6,79 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName                     string
-	EngineName                         string
-	ChaosDuration                      int
-	LIBImage                           string
-	LIBImagePullPolicy                 string
-	RampTime                           int
-	AppNS                              string
-	AppLabel                           string
-	AppKind                            string
-	ChaosUID                           clientTypes.UID
-	InstanceID                         string
-	ChaosNamespace                     string
-	ChaosPodName                       string
-	RunID                              string
-	NetworkPacketDuplicationPercentage string
-	NetworkInterface                   string
-	TargetContainer                    string
-	NetworkLatency                     int
-	NetworkPacketLossPercentage        string
-	NetworkPacketCorruptionPercentage  string
-	Correlation                        int
-	Timeout                            int
-	Delay                              int
-	TargetPods                         string
-	PodsAffectedPerc                   string
-	DestinationIPs                     string
-	DestinationHosts                   string
-	ContainerRuntime                   string
-	ChaosServiceAccount                string
-	SocketPath                         string
-	Sequence                           string
-	TerminationGracePeriodSeconds      int
-	Jitter                             int
-	NetworkChaosType                   string
-	NodeLabel                          string
-	IsTargetContainerProvided          bool
-	SetHelperData                      string
-	SourcePorts                        string
-	DestinationPorts                   string
-	NetworkBandwidth                   string
-	Burst                              string
-	Limit                              string
-	PeakRate                           string
-	MinBurst                           string
+	ExperimentName                string `env:"EXPERIMENT_NAME"`
+	EngineName                    string `env:"CHAOSENGINE"`
+	ChaosDuration                 int    `env:"TOTAL_CHAOS_DURATION" default:"60" unit:"s" min:"0"`
+	LIBImage                      string `env:"LIB_IMAGE" default:"litmuschaos/go-runner:latest"`
+	LIBImagePullPolicy            string `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	RampTime                      int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	AppNS                         string
+	AppLabel                      string
+	AppKind                       string
+	ChaosUID                      clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID                    string          `env:"INSTANCE_ID"`
+	ChaosNamespace                string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName                  string          `env:"POD_NAME"`
+	RunID                         string
+	NetworkInterface              string `env:"NETWORK_INTERFACE" default:"eth0"`
+	TargetContainer               string `env:"TARGET_CONTAINER"`
+	Timeout                       int    `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                         int    `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TargetPods                    string `env:"TARGET_PODS"`
+	PodsAffectedPerc              string `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	DestinationIPs                string `env:"DESTINATION_IPS"`
+	DestinationHosts              string `env:"DESTINATION_HOSTS"`
+	ContainerRuntime              string `env:"CONTAINER_RUNTIME" default:"containerd"`
+	ChaosServiceAccount           string `env:"CHAOS_SERVICE_ACCOUNT"`
+	SocketPath                    string `env:"SOCKET_PATH" default:"/run/containerd/containerd.sock"`
+	Sequence                      string `env:"SEQUENCE" default:"parallel"`
+	TerminationGracePeriodSeconds int    `env:"TERMINATION_GRACE_PERIOD_SECONDS" unit:"s" min:"0"`
+	NetworkChaosType              string
+	NodeLabel                     string `env:"NODE_LABEL"`
+	IsTargetContainerProvided     bool
+	SetHelperData                 string `env:"SET_HELPER_DATA" default:"true"`
+	SourcePorts                   string `env:"SOURCE_PORTS"`
+	DestinationPorts              string `env:"DESTINATION_PORTS"`
+	// the tunables of the subtypes are loaded only by the experiments reading them
+	NetemTunables
+	LossTunables
+	LatencyTunables
+	CorruptionTunables
+	DuplicationTunables
+	RateLimitTunables
+}
+
+// NetemTunables contains the tunables shared by the netem based experiments
+type NetemTunables struct {
+	Correlation int `env:"CORRELATION" default:"0" min:"0" max:"100"`
+}
+
+// LossTunables contains the tunables of the pod-network-loss experiment
+type LossTunables struct {
+	NetworkPacketLossPercentage string `env:"NETWORK_PACKET_LOSS_PERCENTAGE" default:"100"`
+}
+
+// LatencyTunables contains the tunables of the pod-network-latency experiment
+type LatencyTunables struct {
+	NetworkLatency int `env:"NETWORK_LATENCY" default:"2000" unit:"ms" min:"0"`
+	Jitter         int `env:"JITTER" default:"0" unit:"ms" min:"0"`
+}
+
+// CorruptionTunables contains the tunables of the pod-network-corruption experiment
+type CorruptionTunables struct {
+	NetworkPacketCorruptionPercentage string `env:"NETWORK_PACKET_CORRUPTION_PERCENTAGE" default:"100"`
+}
+
+// DuplicationTunables contains the tunables of the pod-network-duplication experiment
+type DuplicationTunables struct {
+	NetworkPacketDuplicationPercentage string `env:"NETWORK_PACKET_DUPLICATION_PERCENTAGE" default:"100"`
+}
+
+// RateLimitTunables contains the tunables of the pod-network-rate-limit experiment
+type RateLimitTunables struct {
+	NetworkBandwidth string `env:"NETWORK_BANDWIDTH" default:"1mbit"`
+	Burst            string `env:"BURST" default:"32kb"`
+	Limit            string `env:"LIMIT" default:"2mb"`
+	PeakRate         string `env:"PEAK_RATE"`
+	MinBurst         string `env:"MIN_BURST"`
 }
@@ -6,30 +6,30 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName                  string
-	EngineName                      string
-	ChaosDuration                   int
-	RampTime                        int
-	ChaosUID                        clientTypes.UID
-	InstanceID                      string
-	TerminationGracePeriodSeconds   int
-	ChaosNamespace                  string
-	ChaosPodName                    string
+	ExperimentName                  string          `env:"EXPERIMENT_NAME" default:"node-io-stress"`
+	EngineName                      string          `env:"CHAOSENGINE"`
+	ChaosDuration                   int             `env:"TOTAL_CHAOS_DURATION" default:"120" unit:"s" min:"0"`
+	RampTime                        int             `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	ChaosUID                        clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID                      string          `env:"INSTANCE_ID"`
+	TerminationGracePeriodSeconds   int             `env:"TERMINATION_GRACE_PERIOD_SECONDS" unit:"s" min:"0"`
+	ChaosNamespace                  string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName                    string          `env:"POD_NAME"`
 	RunID                           string
-	LIBImage                        string
-	LIBImagePullPolicy              string
-	AuxiliaryAppInfo                string
-	Timeout                         int
-	Delay                           int
-	TargetNodes                     string
-	FilesystemUtilizationPercentage string
-	FilesystemUtilizationBytes      string
-	CPU                             string
-	NumberOfWorkers                 string
-	VMWorkers                       string
-	NodesAffectedPerc               string
-	Sequence                        string
-	TargetContainer                 string
-	NodeLabel                       string
-	SetHelperData                   string
+	LIBImage                        string `env:"LIB_IMAGE" default:"litmuschaos/go-runner:latest"`
+	LIBImagePullPolicy              string `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	AuxiliaryAppInfo                string `env:"AUXILIARY_APPINFO"`
+	Timeout                         int    `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                           int    `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TargetNodes                     string `env:"TARGET_NODES"`
+	FilesystemUtilizationPercentage string `env:"FILESYSTEM_UTILIZATION_PERCENTAGE"`
+	FilesystemUtilizationBytes      string `env:"FILESYSTEM_UTILIZATION_BYTES"`
+	CPU                             string `env:"CPU" default:"1"`
+	NumberOfWorkers                 string `env:"NUMBER_OF_WORKERS" default:"4"`
+	VMWorkers                       string `env:"VM_WORKERS" default:"1"`
+	NodesAffectedPerc               string `env:"NODES_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	Sequence                        string `env:"SEQUENCE" default:"parallel"`
+	TargetContainer                 string `env:"TARGET_CONTAINER"`
+	NodeLabel                       string `env:"NODE_LABEL"`
+	SetHelperData                   string `env:"SET_HELPER_DATA" default:"true"`
 }
@@ -6,29 +6,29 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName                string
-	EngineName                    string
-	ChaosDuration                 int
-	RampTime                      int
+	ExperimentName                string `env:"EXPERIMENT_NAME" default:"node-restart"`
+	EngineName                    string `env:"CHAOSENGINE"`
+	ChaosDuration                 int    `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	RampTime                      int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
 	AppNS                         string
 	AppLabel                      string
 	AppKind                       string
-	ChaosUID                      clientTypes.UID
-	TerminationGracePeriodSeconds int
-	InstanceID                    string
-	ChaosNamespace                string
-	ChaosPodName                  string
+	ChaosUID                      clientTypes.UID `env:"CHAOS_UID"`
+	TerminationGracePeriodSeconds int             `env:"TERMINATION_GRACE_PERIOD_SECONDS" unit:"s" min:"0"`
+	InstanceID                    string          `env:"INSTANCE_ID"`
+	ChaosNamespace                string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName                  string          `env:"POD_NAME"`
 	RunID                         string
-	LIBImage                      string
-	LIBImagePullPolicy            string
-	AuxiliaryAppInfo              string
-	Timeout                       int
-	Delay                         int
-	SSHUser                       string
-	RebootCommand                 string
-	TargetNode                    string
-	TargetNodeIP                  string
-	TargetContainer               string
-	NodeLabel                     string
-	SetHelperData                 string
+	LIBImage                      string `env:"LIB_IMAGE" default:"litmuschaos/go-runner:latest"`
+	LIBImagePullPolicy            string `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	AuxiliaryAppInfo              string `env:"AUXILIARY_APPINFO"`
+	Timeout                       int    `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                         int    `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	SSHUser                       string `env:"SSH_USER" default:"root"`
+	RebootCommand                 string `env:"REBOOT_COMMAND" default:"sudo systemctl reboot"`
+	TargetNode                    string `env:"TARGET_NODE"`
+	TargetNodeIP                  string `env:"TARGET_NODE_IP"`
+	TargetContainer               string `env:"TARGET_CONTAINER"`
+	NodeLabel                     string `env:"NODE_LABEL"`
+	SetHelperData                 string `env:"SET_HELPER_DATA" default:"true"`
 }
@@ -6,25 +6,25 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName      string
-	EngineName          string
-	ChaosDuration       int
-	RampTime            int
-	Replicas            int
+	ExperimentName      string `env:"EXPERIMENT_NAME" default:"pod-autoscaler"`
+	EngineName          string `env:"CHAOSENGINE"`
+	ChaosDuration       int    `env:"TOTAL_CHAOS_DURATION" default:"60" unit:"s" min:"0"`
+	RampTime            int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	Replicas            int    `env:"REPLICA_COUNT" min:"0"`
 	AppNS               string
 	AppLabel            string
 	AppKind             string
-	AppAffectPercentage int
-	ChaosUID            clientTypes.UID
-	InstanceID          string
-	ChaosNamespace      string
-	ChaosPodName        string
+	AppAffectPercentage int             `env:"APP_AFFECT_PERC" default:"100" min:"0" max:"100"`
+	ChaosUID            clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID          string          `env:"INSTANCE_ID"`
+	ChaosNamespace      string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName        string          `env:"POD_NAME"`
 	RunID               string
-	AuxiliaryAppInfo    string
-	Timeout             int
-	Delay               int
+	AuxiliaryAppInfo    string `env:"AUXILIARY_APPINFO"`
+	Timeout             int    `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay               int    `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
 	LIBImagePullPolicy  string
-	TargetContainer     string
+	TargetContainer     string `env:"TARGET_CONTAINER"`
 }
 
 // ApplicationUnderTest contains the name of the deployment object and the current replica count
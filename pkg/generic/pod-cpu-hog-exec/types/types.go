@@ -7,28 +7,28 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName                string
-	EngineName                    string
-	ChaosDuration                 int
-	ChaosInterval                 int
-	RampTime                      int
-	ChaosUID                      clientTypes.UID
-	InstanceID                    string
-	ChaosNamespace                string
-	ChaosPodName                  string
-	CPUcores                      int
-	PodsAffectedPerc              int
-	Timeout                       int
-	Delay                         int
-	TargetPods                    string
-	ChaosInjectCmd                string
-	ChaosKillCmd                  string
+	ExperimentName                string          `env:"EXPERIMENT_NAME" default:"pod-cpu-hog"`
+	EngineName                    string          `env:"CHAOSENGINE"`
+	ChaosDuration                 int             `env:"TOTAL_CHAOS_DURATION" default:"60" unit:"s" min:"0"`
+	ChaosInterval                 int             `env:"CHAOS_INTERVAL" default:"10" unit:"s" min:"0"`
+	RampTime                      int             `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	ChaosUID                      clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID                    string          `env:"INSTANCE_ID"`
+	ChaosNamespace                string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName                  string          `env:"POD_NAME"`
+	CPUcores                      int             `env:"CPU_CORES" default:"1" min:"0"`
+	PodsAffectedPerc              int             `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	Timeout                       int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                         int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TargetPods                    string          `env:"TARGET_PODS"`
+	ChaosInjectCmd                string          `env:"CHAOS_INJECT_COMMAND" default:"md5sum /dev/zero"`
+	ChaosKillCmd                  string          `env:"CHAOS_KILL_COMMAND" default:"kill $(find /proc -name exe -lname '*/md5sum' 2>&1 | grep -v 'Permission denied' | awk -F/ '{print $(NF-1)}')"`
 	LIBImagePullPolicy            string
 	Annotations                   map[string]string
-	TargetContainer               string
-	Sequence                      string
+	TargetContainer               string `env:"TARGET_CONTAINER"`
+	Sequence                      string `env:"SEQUENCE" default:"parallel"`
 	Resources                     corev1.ResourceRequirements
 	ImagePullSecrets              []corev1.LocalObjectReference
-	TerminationGracePeriodSeconds int
+	TerminationGracePeriodSeconds int `env:"TERMINATION_GRACE_PERIOD_SECONDS" unit:"s" min:"0"`
 	IsTargetContainerProvided     bool
 }
@@ -6,28 +6,28 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName              string
-	EngineName                  string
-	ChaosDuration               int
-	ChaosInterval               string
-	RampTime                    int
-	Force                       bool
-	ChaosServiceAccount         string
+	ExperimentName              string `env:"EXPERIMENT_NAME" default:"pod-delete"`
+	EngineName                  string `env:"CHAOSENGINE"`
+	ChaosDuration               int    `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	ChaosInterval               string `env:"CHAOS_INTERVAL" default:"10"`
+	RampTime                    int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	Force                       bool   `env:"FORCE" default:"false"`
+	ChaosServiceAccount         string `env:"CHAOS_SERVICE_ACCOUNT"`
 	AppNS                       string
 	AppLabel                    string
 	AppKind                     string
-	ChaosUID                    clientTypes.UID
-	InstanceID                  string
-	ChaosNamespace              string
-	ChaosPodName                string
-	Timeout                     int
-	Delay                       int
-	TargetPods                  string
-	PodsAffectedPerc            string
-	Sequence                    string
+	ChaosUID                    clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID                  string          `env:"INSTANCE_ID"`
+	ChaosNamespace              string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName                string          `env:"POD_NAME"`
+	Timeout                     int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                       int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TargetPods                  string          `env:"TARGET_PODS"`
+	PodsAffectedPerc            string          `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	Sequence                    string          `env:"SEQUENCE" default:"parallel"`
 	LIBImagePullPolicy          string
-	TargetContainer             string
-	NodeLabel                   string
-	PodTerminationOrder         string
-	InterPodKillIntervalSeconds int
+	TargetContainer             string `env:"TARGET_CONTAINER"`
+	NodeLabel                   string `env:"NODE_LABEL"`
+	PodTerminationOrder         string `env:"POD_TERMINATION_ORDER" default:"random"`
+	InterPodKillIntervalSeconds int    `env:"INTER_POD_KILL_INTERVAL_SECONDS" default:"0" unit:"s" min:"0"`
 }
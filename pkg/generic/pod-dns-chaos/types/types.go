@@ -6,34 +6,34 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName                string
-	EngineName                    string
-	ChaosDuration                 int
-	LIBImage                      string
-	LIBImagePullPolicy            string
-	RampTime                      int
+	ExperimentName                string `env:"EXPERIMENT_NAME"`
+	EngineName                    string `env:"CHAOSENGINE"`
+	ChaosDuration                 int    `env:"TOTAL_CHAOS_DURATION" default:"60" unit:"s" min:"0"`
+	LIBImage                      string `env:"LIB_IMAGE" default:"litmuschaos/go-runner:latest"`
+	LIBImagePullPolicy            string `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	RampTime                      int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
 	AppNS                         string
 	AppLabel                      string
 	AppKind                       string
-	ChaosUID                      clientTypes.UID
-	InstanceID                    string
-	ChaosNamespace                string
-	ChaosPodName                  string
+	ChaosUID                      clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID                    string          `env:"INSTANCE_ID"`
+	ChaosNamespace                string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName                  string          `env:"POD_NAME"`
 	RunID                         string
-	Timeout                       int
-	Delay                         int
-	TargetContainer               string
-	TargetPods                    string
-	PodsAffectedPerc              int
-	TargetHostNames               string
-	SpoofMap                      string
-	MatchScheme                   string
-	ChaosType                     string
-	ContainerRuntime              string
-	ChaosServiceAccount           string
-	Sequence                      string
-	SocketPath                    string
-	TerminationGracePeriodSeconds int
+	Timeout                       int    `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                         int    `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TargetContainer               string `env:"TARGET_CONTAINER"`
+	TargetPods                    string `env:"TARGET_PODS"`
+	PodsAffectedPerc              int    `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	TargetHostNames               string `env:"TARGET_HOSTNAMES"`
+	SpoofMap                      string `env:"SPOOF_MAP"`
+	MatchScheme                   string `env:"MATCH_SCHEME" default:"exact"`
+	ChaosType                     string `env:"CHAOS_TYPE"`
+	ContainerRuntime              string `env:"CONTAINER_RUNTIME" default:"containerd"`
+	ChaosServiceAccount           string `env:"CHAOS_SERVICE_ACCOUNT"`
+	Sequence                      string `env:"SEQUENCE" default:"parallel"`
+	SocketPath                    string `env:"SOCKET_PATH" default:"/run/containerd/containerd.sock"`
+	TerminationGracePeriodSeconds int    `env:"TERMINATION_GRACE_PERIOD_SECONDS" unit:"s" min:"0"`
 	IsTargetContainerProvided     bool
-	SetHelperData                 string
+	SetHelperData                 string `env:"SET_HELPER_DATA" default:"true"`
 }
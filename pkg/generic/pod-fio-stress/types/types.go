@@ -6,30 +6,30 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName            string
-	EngineName                string
-	ChaosDuration             int
-	ChaosInterval             int
-	RampTime                  int
-	ChaosUID                  clientTypes.UID
-	InstanceID                string
-	ChaosNamespace            string
-	ChaosPodName              string
-	Timeout                   int
-	Delay                     int
-	TargetContainer           string
+	ExperimentName            string          `env:"EXPERIMENT_NAME"`
+	EngineName                string          `env:"CHAOSENGINE"`
+	ChaosDuration             int             `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	ChaosInterval             int             `env:"CHAOS_INTERVAL" default:"10" unit:"s" min:"0"`
+	RampTime                  int             `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	ChaosUID                  clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID                string          `env:"INSTANCE_ID"`
+	ChaosNamespace            string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName              string          `env:"POD_NAME"`
+	Timeout                   int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                     int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TargetContainer           string          `env:"TARGET_CONTAINER"`
 	ChaosInjectCmd            string
-	ChaosKillCmd              string
-	PodsAffectedPerc          int
-	TargetPods                string
+	ChaosKillCmd              string `env:"CHAOS_KILL_COMMAND" default:"killall fio"`
+	PodsAffectedPerc          int    `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	TargetPods                string `env:"TARGET_PODS"`
 	LIBImagePullPolicy        string
-	Sequence                  string
-	IOEngine                  string
-	IODepth                   int
-	ReadWrite                 string
-	BlockSize                 string
-	Size                      string
-	NumJobs                   int
-	GroupReporting            bool
+	Sequence                  string `env:"SEQUENCE"`
+	IOEngine                  string `env:"IO_ENGINE"`
+	IODepth                   int    `env:"IO_DEPTH" min:"0"`
+	ReadWrite                 string `env:"READ_WRITE_MODE"`
+	BlockSize                 string `env:"BLOCK_SIZE"`
+	Size                      string `env:"SIZE"`
+	NumJobs                   int    `env:"NUMBER_OF_JOBS" min:"0"`
+	GroupReporting            bool   `env:"GROUP_REPORTING" default:"true"`
 	IsTargetContainerProvided bool
 }
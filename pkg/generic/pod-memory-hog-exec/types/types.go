@@ -7,25 +7,25 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName            string
-	EngineName                string
-	ChaosDuration             int
-	ChaosInterval             int
-	RampTime                  int
-	ChaosUID                  clientTypes.UID
-	InstanceID                string
-	ChaosNamespace            string
-	ChaosPodName              string
-	PodsAffectedPerc          int
-	MemoryConsumption         int
-	Timeout                   int
-	Delay                     int
-	TargetPods                string
-	ChaosKillCmd              string
-	LIBImagePullPolicy        string
+	ExperimentName            string          `env:"EXPERIMENT_NAME" default:"pod-memory-hog"`
+	EngineName                string          `env:"CHAOSENGINE"`
+	ChaosDuration             int             `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	ChaosInterval             int             `env:"CHAOS_INTERVAL" default:"10" unit:"s" min:"0"`
+	RampTime                  int             `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	ChaosUID                  clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID                string          `env:"INSTANCE_ID"`
+	ChaosNamespace            string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName              string          `env:"POD_NAME"`
+	PodsAffectedPerc          int             `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	MemoryConsumption         int             `env:"MEMORY_CONSUMPTION" default:"500" min:"0"`
+	Timeout                   int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                     int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TargetPods                string          `env:"TARGET_PODS"`
+	ChaosKillCmd              string          `env:"CHAOS_KILL_COMMAND" default:"kill $(find /proc -name exe -lname '*/dd' 2>&1 | grep -v 'Permission denied' | awk -F/ '{print $(NF-1)}' | head -n 1)"`
+	LIBImagePullPolicy        string          `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
 	Annotations               map[string]string
-	TargetContainer           string
-	Sequence                  string
+	TargetContainer           string `env:"TARGET_CONTAINER"`
+	Sequence                  string `env:"SEQUENCE" default:"parallel"`
 	IsTargetContainerProvided bool
 	Resources                 corev1.ResourceRequirements
 	ImagePullSecrets          []corev1.LocalObjectReference
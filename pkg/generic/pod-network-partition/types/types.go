@@ -6,25 +6,25 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName     string
-	EngineName         string
-	ChaosDuration      int
-	RampTime           int
-	AppNS              string
-	AppLabel           string
-	AppKind            string
-	ChaosUID           clientTypes.UID
-	InstanceID         string
-	LIBImagePullPolicy string
-	ChaosNamespace     string
-	ChaosPodName       string
-	Timeout            int
-	Delay              int
-	TargetContainer    string
-	DestinationHosts   string
-	DestinationIPs     string
-	PolicyTypes        string
-	PodSelector        string
-	NamespaceSelector  string
-	PORTS              string
+	ExperimentName     string          `env:"EXPERIMENT_NAME" default:"pod-network-partition"`
+	EngineName         string          `env:"CHAOSENGINE"`
+	ChaosDuration      int             `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	RampTime           int             `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	AppNS              string          `env:"APP_NAMESPACE"`
+	AppLabel           string          `env:"APP_LABEL"`
+	AppKind            string          `env:"APP_KIND"`
+	ChaosUID           clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID         string          `env:"INSTANCE_ID"`
+	LIBImagePullPolicy string          `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	ChaosNamespace     string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName       string          `env:"POD_NAME"`
+	Timeout            int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay              int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TargetContainer    string          `env:"TARGET_CONTAINER"`
+	DestinationHosts   string          `env:"DESTINATION_HOSTS"`
+	DestinationIPs     string          `env:"DESTINATION_IPS"`
+	PolicyTypes        string          `env:"POLICY_TYPES" default:"all"`
+	PodSelector        string          `env:"POD_SELECTOR"`
+	NamespaceSelector  string          `env:"NAMESPACE_SELECTOR"`
+	PORTS              string          `env:"PORTS"`
 }
@@ -6,39 +6,39 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName                  string
-	EngineName                      string
-	ChaosDuration                   int
-	LIBImage                        string
-	LIBImagePullPolicy              string
-	RampTime                        int
+	ExperimentName                  string `env:"EXPERIMENT_NAME"`
+	EngineName                      string `env:"CHAOSENGINE"`
+	ChaosDuration                   int    `env:"TOTAL_CHAOS_DURATION" default:"60" unit:"s" min:"0"`
+	LIBImage                        string `env:"LIB_IMAGE" default:"litmuschaos/go-runner:latest"`
+	LIBImagePullPolicy              string `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	RampTime                        int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
 	AppNS                           string
 	AppLabel                        string
 	AppKind                         string
-	ChaosUID                        clientTypes.UID
-	InstanceID                      string
-	ChaosNamespace                  string
-	ChaosPodName                    string
+	ChaosUID                        clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID                      string          `env:"INSTANCE_ID"`
+	ChaosNamespace                  string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName                    string          `env:"POD_NAME"`
 	RunID                           string
-	TargetContainer                 string
-	Timeout                         int
-	Delay                           int
-	TargetPods                      string
-	PodsAffectedPerc                string
-	ContainerRuntime                string
-	ChaosServiceAccount             string
-	SocketPath                      string
-	Sequence                        string
-	TerminationGracePeriodSeconds   int
-	CPUcores                        string
-	CPULoad                         string
-	FilesystemUtilizationPercentage string
-	FilesystemUtilizationBytes      string
-	NumberOfWorkers                 string
-	MemoryConsumption               string
-	VolumeMountPath                 string
+	TargetContainer                 string `env:"TARGET_CONTAINER"`
+	Timeout                         int    `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                           int    `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TargetPods                      string `env:"TARGET_PODS"`
+	PodsAffectedPerc                string `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	ContainerRuntime                string `env:"CONTAINER_RUNTIME" default:"containerd"`
+	ChaosServiceAccount             string `env:"CHAOS_SERVICE_ACCOUNT"`
+	SocketPath                      string `env:"SOCKET_PATH" default:"/run/containerd/containerd.sock"`
+	Sequence                        string `env:"SEQUENCE" default:"parallel"`
+	TerminationGracePeriodSeconds   int    `env:"TERMINATION_GRACE_PERIOD_SECONDS" unit:"s" min:"0"`
+	CPUcores                        string `env:"CPU_CORES" default:"0"`
+	CPULoad                         string `env:"CPU_LOAD" default:"100"`
+	FilesystemUtilizationPercentage string `env:"FILESYSTEM_UTILIZATION_PERCENTAGE"`
+	FilesystemUtilizationBytes      string `env:"FILESYSTEM_UTILIZATION_BYTES"`
+	NumberOfWorkers                 string `env:"NUMBER_OF_WORKERS" default:"4"`
+	MemoryConsumption               string `env:"MEMORY_CONSUMPTION" default:"500"`
+	VolumeMountPath                 string `env:"VOLUME_MOUNT_PATH"`
 	StressType                      string
 	IsTargetContainerProvided       bool
-	NodeLabel                       string
-	SetHelperData                   string
+	NodeLabel                       string `env:"NODE_LABEL"`
+	SetHelperData                   string `env:"SET_HELPER_DATA" default:"true"`
 }
@@ -0,0 +1,271 @@
+package journal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/result"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientTypes "k8s.io/apimachinery/pkg/types"
+	k8sretry "k8s.io/client-go/util/retry"
+)
+
+// the journal is a configmap per node, it contains an entry per mutation applied by the helpers on that node
+const (
+	configMapPrefix = "litmus-revert-journal-"
+	NodeLabel       = "litmuschaos.io/journal-node"
+)
+
+// Entry is a mutation applied by a helper on a target, it contains the details required to revert it
+type Entry struct {
+	Fault     string          `json:"fault"`
+	Pod       string          `json:"pod"`
+	Namespace string          `json:"namespace"`
+	Container string          `json:"container"`
+	PodUID    clientTypes.UID `json:"podUID,omitempty"`
+	// HelperPod is the helper which applied the mutation
+	HelperPod string `json:"helperPod"`
+	// Result is the chaosresult, which is annotated once the mutation is reverted
+	Result    string    `json:"result,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	// Details contains the fault specific details, such as the network interface or the pid of the stress process
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// Key returns the key of the entry inside the journal configmap
+func (entry Entry) Key() string {
+	return fmt.Sprintf("%s.%s.%s.%s", entry.Fault, entry.Namespace, entry.Pod, entry.Container)
+}
+
+// Reverter reverts the given mutation, it is registered by the helpers for their fault
+type Reverter func(entry Entry) error
+
+var (
+	mu        sync.RWMutex
+	reverters = map[string]Reverter{}
+)
+
+// RegisterReverter registers the reverter of the given fault, it is used to reconcile the leftover mutations
+func RegisterReverter(fault string, reverter Reverter) {
+	mu.Lock()
+	defer mu.Unlock()
+	reverters[fault] = reverter
+}
+
+func getReverter(fault string) (Reverter, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	reverter, ok := reverters[fault]
+	return reverter, ok
+}
+
+// Journal persists the mutations applied on a node, so that they can be reverted by a replacement helper or a cleanup job
+// if the helper gets killed before reverting them
+// a nil journal is a no-op, so that the helpers keep working if the journal is unavailable
+type Journal struct {
+	clients   clients.ClientSets
+	namespace string
+	node      string
+	helperPod string
+}
+
+// New returns the journal of the node, where the given helper pod is running
+func New(clients clients.ClientSets, namespace, helperPod string) (*Journal, error) {
+	pod, err := clients.KubeClient.CoreV1().Pods(namespace).Get(context.Background(), helperPod, v1.GetOptions{})
+	if err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeHelper, Source: helperPod, Target: fmt.Sprintf("{podName: %s, namespace: %s}", helperPod, namespace), Reason: fmt.Sprintf("failed to get the helper pod: %s", err.Error())}
+	}
+	return ForNode(clients, namespace, pod.Spec.NodeName, helperPod), nil
+}
+
+// ForNode returns the journal of the given node
+func ForNode(clients clients.ClientSets, namespace, node, helperPod string) *Journal {
+	return &Journal{clients: clients, namespace: namespace, node: node, helperPod: helperPod}
+}
+
+func (journal *Journal) configMapName() string {
+	return configMapPrefix + journal.node
+}
+
+// Record persists the given mutation, it is called before applying the mutation
+func (journal *Journal) Record(entry Entry) error {
+	if journal == nil {
+		return nil
+	}
+	entry.HelperPod = journal.helperPod
+	entry.Timestamp = time.Now().UTC()
+	if entry.PodUID == "" {
+		if pod, err := journal.clients.KubeClient.CoreV1().Pods(entry.Namespace).Get(context.Background(), entry.Pod, v1.GetOptions{}); err == nil {
+			entry.PodUID = pod.UID
+		}
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeHelper, Source: journal.helperPod, Reason: fmt.Sprintf("failed to marshal the journal entry: %s", err.Error())}
+	}
+	return journal.update(func(configMap *corev1.ConfigMap) {
+		configMap.Data[entry.Key()] = string(data)
+	})
+}
+
+// Remove removes the given mutation, it is called once the mutation is reverted
+func (journal *Journal) Remove(entry Entry) error {
+	if journal == nil {
+		return nil
+	}
+	return journal.update(func(configMap *corev1.ConfigMap) {
+		delete(configMap.Data, entry.Key())
+	})
+}
+
+// Entries returns the mutations recorded inside the journal
+func (journal *Journal) Entries() ([]Entry, error) {
+	configMap, err := journal.clients.KubeClient.CoreV1().ConfigMaps(journal.namespace).Get(context.Background(), journal.configMapName(), v1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, journal.error(err)
+	}
+
+	var entries []Entry
+	for key, value := range configMap.Data {
+		var entry Entry
+		if err := json.Unmarshal([]byte(value), &entry); err != nil {
+			log.Warnf("[Journal]: Skipping the invalid entry %v, err: %v", key, err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Reconcile reverts the leftover mutations of the given faults, all of them if no fault is provided
+// the mutations of the running helpers are skipped, as they are reverted by the helpers themselves
+// the mutations of the deleted or recreated target pods are removed, as they are gone along with the pods
+func (journal *Journal) Reconcile(faults ...string) ([]Entry, error) {
+	if journal == nil {
+		return nil, nil
+	}
+	entries, err := journal.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Entry
+	for _, entry := range entries {
+		if len(faults) != 0 && !contains(faults, entry.Fault) {
+			continue
+		}
+		if entry.HelperPod != journal.helperPod && journal.isHelperRunning(entry.HelperPod) {
+			continue
+		}
+		if !journal.isTargetPresent(entry) {
+			log.Infof("[Journal]: Target pod %v/%v is gone, dropping the %v entry", entry.Namespace, entry.Pod, entry.Fault)
+			if err := journal.Remove(entry); err != nil {
+				return reverted, err
+			}
+			continue
+		}
+
+		reverter, ok := getReverter(entry.Fault)
+		if !ok {
+			log.Warnf("[Journal]: No reverter registered for the %v fault, skipping the entry of %v/%v", entry.Fault, entry.Namespace, entry.Pod)
+			continue
+		}
+		log.Infof("[Journal]: Reverting the leftover %v fault on {pod: %v, namespace: %v, container: %v}", entry.Fault, entry.Pod, entry.Namespace, entry.Container)
+		if err := reverter(entry); err != nil {
+			log.Errorf("[Journal]: Unable to revert the leftover %v fault on %v/%v, err: %v", entry.Fault, entry.Namespace, entry.Pod, err)
+			continue
+		}
+		if err := journal.Remove(entry); err != nil {
+			return reverted, err
+		}
+		if entry.Result != "" {
+			if err := result.AnnotateChaosResult(entry.Result, journal.namespace, "reverted", "pod", entry.Pod); err != nil {
+				log.Warnf("[Journal]: Unable to annotate the %v chaosresult, err: %v", entry.Result, err)
+			}
+		}
+		reverted = append(reverted, entry)
+	}
+	return reverted, nil
+}
+
+// isHelperRunning checks whether the given helper pod is still running
+func (journal *Journal) isHelperRunning(helperPod string) bool {
+	pod, err := journal.clients.KubeClient.CoreV1().Pods(journal.namespace).Get(context.Background(), helperPod, v1.GetOptions{})
+	if err != nil {
+		// the helper is considered running, unless it is confirmed to be deleted
+		return !k8serrors.IsNotFound(err)
+	}
+	return pod.Status.Phase == corev1.PodPending || pod.Status.Phase == corev1.PodRunning
+}
+
+// isTargetPresent checks whether the target pod of the entry still exists
+func (journal *Journal) isTargetPresent(entry Entry) bool {
+	pod, err := journal.clients.KubeClient.CoreV1().Pods(entry.Namespace).Get(context.Background(), entry.Pod, v1.GetOptions{})
+	if err != nil {
+		return !k8serrors.IsNotFound(err)
+	}
+	return entry.PodUID == "" || pod.UID == entry.PodUID
+}
+
+// update applies the given change on the journal configmap, it creates the configmap if not present
+func (journal *Journal) update(change func(configMap *corev1.ConfigMap)) error {
+	configMaps := journal.clients.KubeClient.CoreV1().ConfigMaps(journal.namespace)
+
+	err := k8sretry.RetryOnConflict(k8sretry.DefaultRetry, func() error {
+		configMap, err := configMaps.Get(context.Background(), journal.configMapName(), v1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      journal.configMapName(),
+					Namespace: journal.namespace,
+					Labels:    map[string]string{NodeLabel: journal.node},
+				},
+				Data: map[string]string{},
+			}
+			change(configMap)
+			_, err = configMaps.Create(context.Background(), configMap, v1.CreateOptions{})
+			if k8serrors.IsAlreadyExists(err) {
+				// created by another helper in the meantime, retry as a conflict
+				return k8serrors.NewConflict(corev1.Resource("configmaps"), journal.configMapName(), err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		change(configMap)
+		_, err = configMaps.Update(context.Background(), configMap, v1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return journal.error(err)
+	}
+	return nil
+}
+
+func (journal *Journal) error(err error) error {
+	return cerrors.Error{ErrorCode: cerrors.ErrorTypeHelper, Source: journal.helperPod, Target: fmt.Sprintf("{configmap: %s, namespace: %s}", journal.configMapName(), journal.namespace), Reason: fmt.Sprintf("failed to update the revert journal: %s", err.Error())}
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package journal
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+)
+
+func TestKey(t *testing.T) {
+	entry := Entry{Fault: "network-chaos", Namespace: "default", Pod: "nginx-7f8d9", Container: "nginx"}
+	assert.Equal(t, "network-chaos.default.nginx-7f8d9.nginx", entry.Key())
+}
+
+func TestNilJournal(t *testing.T) {
+	// the helpers keep working without the journal, if it is unavailable
+	var journal *Journal
+	entry := Entry{Fault: "stress-chaos", Namespace: "default", Pod: "nginx-7f8d9", Container: "nginx"}
+
+	assert.NoError(t, journal.Record(entry))
+	assert.NoError(t, journal.Remove(entry))
+	reverted, err := journal.Reconcile()
+	assert.NoError(t, err)
+	assert.Empty(t, reverted)
+}
+
+// fakeAPIServer is an in-memory api server, which serves the pods and the configmaps of the journal
+type fakeAPIServer struct {
+	sync.Mutex
+	pods       map[string]corev1.Pod
+	configMaps map[string]corev1.ConfigMap
+	// conflicts is the count of the configmap updates, which are rejected with a conflict
+	conflicts int
+	updates   int
+}
+
+func (s *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+
+	// the paths are /api/v1/namespaces/<namespace>/<resource>[/<name>]
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/namespaces/"), "/")
+	key := parts[0] + "/" + parts[len(parts)-1]
+	switch {
+	case parts[1] == "pods" && r.Method == http.MethodGet:
+		if pod, ok := s.pods[key]; ok {
+			json.NewEncoder(w).Encode(pod)
+			return
+		}
+	case parts[1] == "configmaps" && r.Method == http.MethodGet:
+		if configMap, ok := s.configMaps[key]; ok {
+			json.NewEncoder(w).Encode(configMap)
+			return
+		}
+	case parts[1] == "configmaps" && r.Method == http.MethodPost:
+		var configMap corev1.ConfigMap
+		json.NewDecoder(r.Body).Decode(&configMap)
+		s.configMaps[parts[0]+"/"+configMap.Name] = configMap
+		json.NewEncoder(w).Encode(configMap)
+		return
+	case parts[1] == "configmaps" && r.Method == http.MethodPut:
+		s.updates++
+		if s.conflicts > 0 {
+			s.conflicts--
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(v1.Status{Status: v1.StatusFailure, Reason: v1.StatusReasonConflict, Code: http.StatusConflict})
+			return
+		}
+		var configMap corev1.ConfigMap
+		json.NewDecoder(r.Body).Decode(&configMap)
+		s.configMaps[key] = configMap
+		json.NewEncoder(w).Encode(configMap)
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+	json.NewEncoder(w).Encode(v1.Status{Status: v1.StatusFailure, Reason: v1.StatusReasonNotFound, Code: http.StatusNotFound})
+}
+
+func newFakeAPIServer(t *testing.T, pods ...corev1.Pod) (*fakeAPIServer, clients.ClientSets) {
+	server := &fakeAPIServer{pods: map[string]corev1.Pod{}, configMaps: map[string]corev1.ConfigMap{}}
+	for _, pod := range pods {
+		server.pods[pod.Namespace+"/"+pod.Name] = pod
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	kubeClient, err := kubernetes.NewForConfig(&rest.Config{Host: httpServer.URL})
+	require.NoError(t, err)
+	return server, clients.ClientSets{KubeClient: kubeClient}
+}
+
+func newPod(namespace, name string, uid clientTypes.UID, phase corev1.PodPhase) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: name, Namespace: namespace, UID: uid},
+		Status:     corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestRecordAndRemove(t *testing.T) {
+	server, clientSets := newFakeAPIServer(t, newPod("default", "nginx-1", "uid-1", corev1.PodRunning))
+	journal := ForNode(clientSets, "litmus", "node-1", "network-chaos-helper-abcd")
+
+	// the configmap is created with the first entry
+	entry := Entry{Fault: "network-chaos", Namespace: "default", Pod: "nginx-1", Container: "nginx"}
+	require.NoError(t, journal.Record(entry))
+	configMap := server.configMaps["litmus/litmus-revert-journal-node-1"]
+	assert.Equal(t, "node-1", configMap.Labels[NodeLabel])
+	entries, err := journal.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, clientTypes.UID("uid-1"), entries[0].PodUID)
+	assert.Equal(t, "network-chaos-helper-abcd", entries[0].HelperPod)
+
+	// the conflicting updates are retried
+	server.conflicts = 2
+	other := Entry{Fault: "network-chaos", Namespace: "default", Pod: "nginx-2", Container: "nginx"}
+	require.NoError(t, journal.Record(other))
+	assert.Equal(t, 3, server.updates)
+	entries, err = journal.Entries()
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	require.NoError(t, journal.Remove(entry))
+	entries, err = journal.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "nginx-2", entries[0].Pod)
+}
+
+func TestReconcile(t *testing.T) {
+	server, clientSets := newFakeAPIServer(t,
+		newPod("litmus", "stress-helper-running", "", corev1.PodRunning),
+		newPod("default", "nginx-1", "uid-1", corev1.PodRunning),
+		newPod("default", "nginx-2", "uid-2", corev1.PodRunning),
+		// nginx-3 is recreated with a new uid after the mutation is recorded
+		newPod("default", "nginx-3", "uid-3-new", corev1.PodRunning),
+	)
+
+	var revertedPods []string
+	RegisterReverter("test-fault", func(entry Entry) error {
+		revertedPods = append(revertedPods, entry.Pod)
+		return nil
+	})
+
+	record := func(helperPod, pod string, uid clientTypes.UID) {
+		journal := ForNode(clientSets, "litmus", "node-1", helperPod)
+		require.NoError(t, journal.Record(Entry{Fault: "test-fault", Namespace: "default", Pod: pod, Container: "nginx", PodUID: uid}))
+	}
+	record("stress-helper-running", "nginx-1", "uid-1")
+	record("stress-helper-deleted", "nginx-2", "uid-2")
+	record("stress-helper-deleted", "nginx-3", "uid-3")
+
+	reverted, err := ForNode(clientSets, "litmus", "node-1", "revert-abcd").Reconcile("test-fault")
+	require.NoError(t, err)
+	require.Len(t, reverted, 1)
+	assert.Equal(t, "nginx-2", reverted[0].Pod)
+	assert.Equal(t, []string{"nginx-2"}, revertedPods)
+
+	// the entry of the running helper is kept, while the entries of the reverted and the recreated targets are removed
+	configMap := server.configMaps["litmus/litmus-revert-journal-node-1"]
+	assert.Len(t, configMap.Data, 1)
+	assert.Contains(t, configMap.Data, "test-fault.default.nginx-1.nginx")
+}
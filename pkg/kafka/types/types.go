@@ -8,20 +8,20 @@ import (
 type ExperimentDetails struct {
 	ChaoslibDetail        *exp.ExperimentDetails
 	ExperimentName        string
-	KafkaKind             string
-	KafkaLivenessStream   string
-	KafkaLivenessImage    string
-	KafkaConsumerTimeout  int
-	KafkaInstanceName     string
-	KafkaNamespace        string
-	KafkaLabel            string
-	KafkaBroker           string
-	KafkaRepliationFactor string
-	KafkaService          string
-	KafkaPort             string
-	ZookeeperNamespace    string
-	ZookeeperLabel        string
-	ZookeeperService      string
-	ZookeeperPort         string
-	RunID                 string
+	KafkaKind             string `env:"KAFKA_KIND" default:"statefulset"`
+	KafkaLivenessStream   string `env:"KAFKA_LIVENESS_STREAM" default:"enable"`
+	KafkaLivenessImage    string `env:"KAFKA_LIVENESS_IMAGE" default:"litmuschaos/kafka-client:latest"`
+	KafkaConsumerTimeout  int    `env:"KAFKA_CONSUMER_TIMEOUT" default:"60000" unit:"ms" min:"0"`
+	KafkaInstanceName     string `env:"KAFKA_INSTANCE_NAME"`
+	KafkaNamespace        string `env:"KAFKA_NAMESPACE" default:"default"`
+	KafkaLabel            string `env:"KAFKA_LABEL"`
+	KafkaBroker           string `env:"KAFKA_BROKER"`
+	KafkaRepliationFactor string `env:"KAFKA_REPLICATION_FACTOR"`
+	KafkaService          string `env:"KAFKA_SERVICE"`
+	KafkaPort             string `env:"KAFKA_PORT" default:"9092"`
+	ZookeeperNamespace    string `env:"ZOOKEEPER_NAMESPACE"`
+	ZookeeperLabel        string `env:"ZOOKEEPER_LABEL"`
+	ZookeeperService      string `env:"ZOOKEEPER_SERVICE"`
+	ZookeeperPort         string `env:"ZOOKEEPER_PORT"`
+	RunID                 string `env:"RunID"`
 }
@@ -6,24 +6,24 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName     string
-	EngineName         string
-	ChaosDuration      int
-	ChaosInterval      int
-	RampTime           int
+	ExperimentName     string `env:"EXPERIMENT_NAME"`
+	EngineName         string `env:"CHAOSENGINE"`
+	ChaosDuration      int    `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	ChaosInterval      int    `env:"CHAOS_INTERVAL" default:"30" unit:"s" min:"0"`
+	RampTime           int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
 	AppNS              string
 	AppLabel           string
 	AppKind            string
-	ChaosUID           clientTypes.UID
-	InstanceID         string
-	ChaosNamespace     string
-	ChaosPodName       string
-	Timeout            int
-	Delay              int
-	Sequence           string
-	VolumeTag          string
-	Region             string
-	VolumeAffectedPerc int
+	ChaosUID           clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID         string          `env:"INSTANCE_ID"`
+	ChaosNamespace     string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName       string          `env:"POD_NAME"`
+	Timeout            int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay              int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	Sequence           string          `env:"SEQUENCE" default:"parallel"`
+	VolumeTag          string          `env:"EBS_VOLUME_TAG"`
+	Region             string          `env:"REGION"`
+	VolumeAffectedPerc int             `env:"VOLUME_AFFECTED_PERC" default:"0" min:"0" max:"100"`
 	EBSVolumeID        string
 	TargetVolumeIDList []string
 }
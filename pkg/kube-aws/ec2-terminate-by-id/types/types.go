@@ -6,20 +6,20 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName   string
-	EngineName       string
-	RampTime         int
-	ChaosDuration    int
-	ChaosInterval    int
-	ChaosUID         clientTypes.UID
-	InstanceID       string
-	ChaosNamespace   string
-	ChaosPodName     string
-	Timeout          int
-	Delay            int
+	ExperimentName   string          `env:"EXPERIMENT_NAME" default:"ec2-terminate-by-id"`
+	EngineName       string          `env:"CHAOSENGINE"`
+	RampTime         int             `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	ChaosDuration    int             `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	ChaosInterval    int             `env:"CHAOS_INTERVAL" default:"30" unit:"s" min:"0"`
+	ChaosUID         clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID       string          `env:"INSTANCE_ID"`
+	ChaosNamespace   string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName     string          `env:"POD_NAME"`
+	Timeout          int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay            int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
 	Ec2InstanceID    string
-	Region           string
-	ManagedNodegroup string
-	Sequence         string
+	Region           string `env:"REGION"`
+	ManagedNodegroup string `env:"MANAGED_NODEGROUP" default:"disable"`
+	Sequence         string `env:"SEQUENCE" default:"parallel"`
 	ActiveNodes      int
 }
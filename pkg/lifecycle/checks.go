@@ -0,0 +1,65 @@
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/status"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+// AUTStatusCheck verifies that the application under test is running
+// it is skipped if the default health checks are disabled
+func AUTStatusCheck(ctx context.Context, state *State) error {
+	if !state.ChaosDetails.DefaultHealthCheck {
+		return nil
+	}
+	log.Infof("[Status]: Verify that the AUT (Application Under Test) is running (%v)", phaseName(state))
+	return status.AUTStatusCheck(state.Clients, state.ChaosDetails)
+}
+
+// AuxiliaryAppStatusCheck verifies that the auxiliary applications are running
+// it is skipped if the default health checks are disabled or no auxiliary application is provided
+func AuxiliaryAppStatusCheck(state *State, auxiliaryAppInfo string, timeout, delay int) error {
+	if !state.ChaosDetails.DefaultHealthCheck || auxiliaryAppInfo == "" {
+		return nil
+	}
+	log.Infof("[Status]: Verify that the Auxiliary Applications are running (%v)", phaseName(state))
+	return status.CheckAuxiliaryApplicationStatus(auxiliaryAppInfo, timeout, delay, state.Clients)
+}
+
+// NodeStatusCheck verifies that the target nodes are in ready state
+// the nodes may need manual recovery after the chaos, so the post-chaos failure is only reported as a warning
+func NodeStatusCheck(state *State, targetNodes string, timeout, delay int) error {
+	if !state.ChaosDetails.DefaultHealthCheck {
+		return nil
+	}
+	log.Info("[Status]: Getting the status of target nodes")
+	err := status.CheckNodeStatus(targetNodes, timeout, delay, state.Clients)
+	if err == nil || state.ChaosDetails.Phase != types.PostChaosPhase {
+		return err
+	}
+	log.Warnf("Target nodes are not in the ready state, you may need to manually recover the node, err: %v", err)
+	types.SetEngineEventAttributes(state.EventsDetails, types.PostChaosCheck, Node.Unhealthy, "Warning", state.ChaosDetails)
+	generateEvents(state, "ChaosEngine")
+	return nil
+}
+
+// NodeStatusChecks verifies the status of the application, auxiliary applications and target nodes
+func NodeStatusChecks(ctx context.Context, state *State, auxiliaryAppInfo, targetNodes string, timeout, delay int) error {
+	if err := AUTStatusCheck(ctx, state); err != nil {
+		return err
+	}
+	if err := AuxiliaryAppStatusCheck(state, auxiliaryAppInfo, timeout, delay); err != nil {
+		return err
+	}
+	return NodeStatusCheck(state, targetNodes, timeout, delay)
+}
+
+// phaseName returns the name of the check, derived from the experiment phase
+func phaseName(state *State) string {
+	if state.ChaosDetails.Phase == types.PostChaosPhase {
+		return "post-chaos"
+	}
+	return "pre-chaos"
+}
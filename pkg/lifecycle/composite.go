@@ -0,0 +1,420 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/probe"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/common"
+	"github.com/litmuschaos/litmus-go/pkg/utils/tunables"
+	"github.com/palantir/stacktrace"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// Fault returns the lifecycle hooks of an experiment, so that it can be injected as a fault of the composite experiment
+type Fault func(clients clients.ClientSets) Experiment
+
+// FaultSpec contains the details of a fault of the composite experiment
+type FaultSpec struct {
+	// Name is the name of the registered fault, such as pod-network-latency
+	Name string `json:"name"`
+	// Env contains the ENVs of the fault, such as the targets and the tunables,
+	// the ENVs of the composite experiment are used for the rest of them
+	Env map[string]string `json:"env,omitempty"`
+	// Delay is the delay in seconds before the fault is injected, it staggers the faults
+	Delay int `json:"delay,omitempty"`
+}
+
+// the phases of the faults, recorded inside the chaosresult
+const (
+	faultAwaited   = "Awaited"
+	faultRunning   = "Running"
+	faultCompleted = "Completed"
+	faultError     = "Error"
+)
+
+var (
+	faultsMu sync.RWMutex
+	faults   = map[string]Fault{}
+)
+
+// RegisterFault makes the given experiment available as a fault of the composite experiment
+// it panics if the name is already registered, as it is a programming error which should be caught at startup
+func RegisterFault(name string, fault Fault) {
+	faultsMu.Lock()
+	defer faultsMu.Unlock()
+
+	if _, ok := faults[name]; ok {
+		panic(fmt.Sprintf("lifecycle: %v fault is already registered", name))
+	}
+	faults[name] = fault
+}
+
+// compositeFault is a fault of the composite experiment, along with its own state
+type compositeFault struct {
+	spec       FaultSpec
+	experiment Experiment
+	state      *State
+	status     types.FaultStatus
+}
+
+// Composite returns the lifecycle hooks of the composite experiment, which injects the given faults concurrently,
+// except for the faults whose chaoslibs watch the abort signal themselves, which are injected one after the other
+// the faults share the probes and the chaosresult of the composite experiment, while their targets and tunables are derived from their own ENVs
+// the faults are provided as a YAML or JSON list of the FaultSpec
+func Composite(clients clients.ClientSets, spec string) Experiment {
+	composite, err := newCompositeFaults(clients, spec)
+
+	// the experiment keeps running upon abort, if any of the faults reverts the chaos itself
+	abortWithoutExit := false
+	for _, f := range composite {
+		abortWithoutExit = abortWithoutExit || f.experiment.AbortWithoutExit
+	}
+
+	return Experiment{
+		DryRunSupported:  true,
+		AbortWithoutExit: abortWithoutExit,
+		GetENV: func() error {
+			if err != nil {
+				return err
+			}
+			for _, f := range composite {
+				if f.experiment.GetENV == nil {
+					continue
+				}
+				if err := withEnv(f.env(), f.experiment.GetENV); err != nil {
+					return stacktrace.Propagate(err, "invalid tunables of %v fault", f.spec.Name)
+				}
+			}
+			return nil
+		},
+		Prepare: func(ctx context.Context, state *State) error {
+			for _, f := range composite {
+				if err := withEnv(f.env(), func() error { return f.prepare(ctx, state) }); err != nil {
+					return stacktrace.Propagate(err, "could not prepare %v fault", f.spec.Name)
+				}
+			}
+			setFaultStatuses(state.ChaosDetails, composite)
+			return nil
+		},
+		Info: func(state *State) logrus.Fields {
+			fields := logrus.Fields{"Chaos Duration": state.ChaosDetails.ChaosDuration}
+			for i, f := range composite {
+				info := logrus.Fields{"Delay": f.spec.Delay}
+				if f.experiment.Info != nil {
+					info = f.experiment.Info(f.state)
+					info["Delay"] = f.spec.Delay
+				}
+				fields[fmt.Sprintf("Fault %d (%s)", i+1, f.spec.Name)] = info
+			}
+			return fields
+		},
+		PreChaosCheck: func(ctx context.Context, state *State) error {
+			return runFaultChecks(ctx, state, composite, func(e Experiment) Hook { return e.PreChaosCheck })
+		},
+		Inject: func(ctx context.Context, state *State) error {
+			return injectFaults(ctx, state, composite)
+		},
+		PostChaosCheck: func(ctx context.Context, state *State) error {
+			return runFaultChecks(ctx, state, composite, func(e Experiment) Hook { return e.PostChaosCheck })
+		},
+	}
+}
+
+// newCompositeFaults parses the fault specs and derives the lifecycle hooks of the faults
+func newCompositeFaults(clients clients.ClientSets, spec string) ([]*compositeFault, error) {
+	specs, err := parseFaultSpecs(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	faultsMu.RLock()
+	defer faultsMu.RUnlock()
+
+	var composite []*compositeFault
+	seen := map[string]bool{}
+	for _, s := range specs {
+		// the chaoslibs keep the state of the chaos inside the package level variables, such as the abort channels
+		if seen[s.Name] {
+			return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{fault: %s}", s.Name), Reason: "duplicate fault, a fault can be injected only once by the composite experiment"}
+		}
+		seen[s.Name] = true
+		fault, ok := faults[s.Name]
+		if !ok {
+			return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{fault: %s}", s.Name), Reason: fmt.Sprintf("unknown fault, supported faults: %s", strings.Join(faultNames(), ", "))}
+		}
+		composite = append(composite, &compositeFault{
+			spec:       s,
+			experiment: fault(clients),
+			status:     types.FaultStatus{Name: s.Name, Phase: faultAwaited},
+		})
+	}
+	return composite, nil
+}
+
+// parseFaultSpecs parses the YAML or JSON list of the fault specs
+func parseFaultSpecs(spec string) ([]FaultSpec, error) {
+	var specs []FaultSpec
+	if err := yaml.UnmarshalStrict([]byte(spec), &specs); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("failed to parse the FAULTS: %s", err.Error())}
+	}
+	if len(specs) == 0 {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: "FAULTS can't be empty"}
+	}
+	for _, s := range specs {
+		if s.Name == "" || s.Delay < 0 {
+			return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{fault: %s}", s.Name), Reason: "fault name can't be empty and delay can't be negative"}
+		}
+		// the ENVs of the steps are process wide, so the faults can't ramp concurrently
+		if _, ok := s.Env["INTENSITY_STEPS"]; ok {
+			return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{fault: %s}", s.Name), Reason: "ramp mode is not supported by the faults of the composite experiment"}
+		}
+	}
+	return specs, nil
+}
+
+// faultNames returns the names of the registered faults, the caller holds the lock
+func faultNames() []string {
+	names := make([]string, 0, len(faults))
+	for name := range faults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// env returns the ENVs of the fault, the name of the fault is exported as the experiment name
+func (f *compositeFault) env() map[string]string {
+	env := map[string]string{"EXPERIMENT_NAME": f.spec.Name}
+	for k, v := range f.spec.Env {
+		env[k] = v
+	}
+	return env
+}
+
+// prepare derives the state of the fault out of the state of the composite experiment, it runs with the ENVs of the fault
+// the fault inherits the attributes derived from the chaosengine, while its tunables and targets are read from its own ENVs
+func (f *compositeFault) prepare(ctx context.Context, state *State) error {
+	chaosDetails := *state.ChaosDetails
+	if err := tunables.Load(&chaosDetails, nil); err != nil {
+		return err
+	}
+	chaosDetails.AppDetail = types.GetTargets(strings.TrimSpace(os.Getenv("TARGETS")))
+	chaosDetails.Targets = []v1alpha1.TargetDetails{}
+	chaosDetails.ParentsResources = []types.ParentResource{}
+	chaosDetails.Plan = nil
+	chaosDetails.Labels = map[string]string{}
+	for k, v := range state.ChaosDetails.Labels {
+		chaosDetails.Labels[k] = v
+	}
+
+	// the probes are run by the composite experiment, so that they are run once for all the faults
+	resultDetails := *state.ResultDetails
+	resultDetails.ProbeDetails = nil
+	resultDetails.ProbeArtifacts = nil
+
+	f.state = &State{
+		Clients:       state.Clients,
+		ChaosDetails:  &chaosDetails,
+		ResultDetails: &resultDetails,
+		EventsDetails: &types.EventDetails{},
+	}
+	if f.experiment.Prepare != nil {
+		return f.experiment.Prepare(ctx, f.state)
+	}
+	return nil
+}
+
+// runFaultChecks runs the given check of all the faults, one after the other
+func runFaultChecks(ctx context.Context, state *State, composite []*compositeFault, check func(Experiment) Hook) error {
+	for _, f := range composite {
+		hook := check(f.experiment)
+		if hook == nil {
+			continue
+		}
+		f.state.ChaosDetails.Phase = state.ChaosDetails.Phase
+		if err := hook(ctx, f.state); err != nil {
+			return stacktrace.Propagate(err, "%v check of %v fault failed", phaseName(state), f.spec.Name)
+		}
+	}
+	return nil
+}
+
+// abortSignal relays the abort signals to the composite experiment, it is a variable so that the tests can stub it
+var abortSignal = func() (<-chan os.Signal, func()) {
+	signChan := make(chan os.Signal, 1)
+	common.NotifyAbort(signChan)
+	return signChan, func() { signal.Stop(signChan) }
+}
+
+// injectFaults injects all the faults, each of them after its delay, and waits for all of them to complete
+// the faults whose chaoslibs watch the abort signal themselves (AbortWithoutExit) revert only their own chaos before exiting the process,
+// so they are injected one after the other, while the rest of the faults are injected concurrently.
+// Upon abort, the faults which are not injected yet are skipped and the experiment is stopped once the running faults return
+// the during-chaos probes are run once, before any of the faults is injected
+func injectFaults(ctx context.Context, state *State, composite []*compositeFault) error {
+	chaosDetails := state.ChaosDetails
+
+	if len(state.ResultDetails.ProbeDetails) != 0 && !chaosDetails.DryRun {
+		if err := probe.RunProbes(ctx, chaosDetails, state.Clients, state.ResultDetails, "DuringChaos", state.EventsDetails); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range composite {
+		f.state.ChaosDetails.Phase = chaosDetails.Phase
+		f.status.Phase = faultRunning
+	}
+	setFaultStatuses(chaosDetails, composite)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	signChan, stop := abortSignal()
+	defer stop()
+	aborted := make(chan struct{})
+	go func() {
+		select {
+		case <-signChan:
+			log.Info("[Abort]: Skipping the faults which are not injected yet")
+			close(aborted)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var wg sync.WaitGroup
+	start := time.Now()
+	errs := make([]error, len(composite))
+	var serial []int
+	for i, f := range composite {
+		if f.experiment.AbortWithoutExit {
+			serial = append(serial, i)
+			continue
+		}
+		wg.Add(1)
+		go func(i int, f *compositeFault) {
+			defer wg.Done()
+			errs[i] = f.inject(ctx, start)
+		}(i, f)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for _, i := range serial {
+			errs[i] = composite[i].inject(ctx, start)
+		}
+	}()
+	wg.Wait()
+
+	// the targets and the plan of the faults are recorded inside the chaosresult of the composite experiment
+	for _, f := range composite {
+		chaosDetails.Targets = append(chaosDetails.Targets, f.state.ChaosDetails.Targets...)
+		for _, step := range f.state.ChaosDetails.Plan {
+			if step.Details == nil {
+				step.Details = map[string]string{}
+			}
+			step.Details["fault"] = f.spec.Name
+			chaosDetails.Plan = append(chaosDetails.Plan, step)
+		}
+	}
+	setFaultStatuses(chaosDetails, composite)
+
+	select {
+	case <-aborted:
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeExperimentAborted, Reason: "experiment is aborted"}
+	default:
+	}
+	for i, err := range errs {
+		if err != nil {
+			return stacktrace.Propagate(err, "%v fault failed", composite[i].spec.Name)
+		}
+	}
+	return nil
+}
+
+// inject injects the fault once its delay, counted from the given start, is elapsed and records its status
+func (f *compositeFault) inject(ctx context.Context, start time.Time) error {
+	chaosDetails := f.state.ChaosDetails
+
+	if chaosDetails.DryRun && !f.experiment.DryRunSupported {
+		common.RecordPlanStep("skip", f.spec.Name+" fault", map[string]string{"reason": "dry-run mode is not supported by the fault"}, chaosDetails)
+		f.status.Phase = faultCompleted
+		return nil
+	}
+
+	if !chaosDetails.DryRun {
+		if wait := time.Until(start.Add(time.Duration(f.spec.Delay) * time.Second)); wait > 0 {
+			log.Infof("[Wait]: Waiting for %vs before injecting the %v fault", wait.Round(time.Second).Seconds(), f.spec.Name)
+			select {
+			case <-ctx.Done():
+			case <-time.After(wait):
+			}
+		}
+		if ctx.Err() != nil {
+			f.status.Phase = faultError
+			f.status.FailStep = "the experiment is cancelled before the fault is injected"
+			return ctx.Err()
+		}
+	}
+
+	log.Infof("[Inject]: Injecting the %v fault", f.spec.Name)
+	f.status.StartTime = time.Now().UTC().Format(time.RFC3339)
+	err := f.experiment.Inject(ctx, f.state)
+	f.status.EndTime = time.Now().UTC().Format(time.RFC3339)
+	if err != nil {
+		log.Errorf("The %v fault failed, err: %v", f.spec.Name, err)
+		f.status.Phase = faultError
+		f.status.FailStep, _ = cerrors.GetRootCauseAndErrorCode(err, string(chaosDetails.Phase))
+		return err
+	}
+	f.status.Phase = faultCompleted
+	return nil
+}
+
+// setFaultStatuses records the statuses of the faults inside the chaos details
+func setFaultStatuses(chaosDetails *types.ChaosDetails, composite []*compositeFault) {
+	statuses := make([]types.FaultStatus, 0, len(composite))
+	for _, f := range composite {
+		statuses = append(statuses, f.status)
+	}
+	chaosDetails.Faults = statuses
+}
+
+// withEnv runs the given function with the given ENVs, the previous ENVs are restored afterwards
+// the ENVs are process wide, so it is used only where nothing else reads them concurrently,
+// i.e, while the faults are prepared one after the other or between the steps of the ramp mode
+func withEnv(env map[string]string, fn func() error) error {
+	previous := map[string]*string{}
+	for k, v := range env {
+		if old, ok := os.LookupEnv(k); ok {
+			previous[k] = &old
+		} else {
+			previous[k] = nil
+		}
+		if err := os.Setenv(k, v); err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("failed to set the %s env: %s", k, err.Error())}
+		}
+	}
+	defer func() {
+		for k, v := range previous {
+			if v == nil {
+				os.Unsetenv(k)
+			} else {
+				os.Setenv(k, *v)
+			}
+		}
+	}()
+	return fn()
+}
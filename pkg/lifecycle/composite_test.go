@@ -0,0 +1,129 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+func TestParseFaultSpecs(t *testing.T) {
+	specs, err := parseFaultSpecs(`
+- name: pod-network-latency
+  env:
+    NETWORK_LATENCY: "2000"
+- name: pod-cpu-hog
+  delay: 30
+`)
+	assert.NoError(t, err)
+	assert.Equal(t, []FaultSpec{
+		{Name: "pod-network-latency", Env: map[string]string{"NETWORK_LATENCY": "2000"}},
+		{Name: "pod-cpu-hog", Delay: 30},
+	}, specs)
+
+	for _, spec := range []string{"", "- name: pod-cpu-hog\n  duration: 30", "- delay: 30", "- name: pod-cpu-hog\n  delay: -1"} {
+		_, err := parseFaultSpecs(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestWithEnv(t *testing.T) {
+	t.Setenv("TOTAL_CHAOS_DURATION", "60")
+	os.Unsetenv("NETWORK_LATENCY")
+
+	err := withEnv(map[string]string{"TOTAL_CHAOS_DURATION": "30", "NETWORK_LATENCY": "2000"}, func() error {
+		assert.Equal(t, "30", os.Getenv("TOTAL_CHAOS_DURATION"))
+		assert.Equal(t, "2000", os.Getenv("NETWORK_LATENCY"))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "60", os.Getenv("TOTAL_CHAOS_DURATION"))
+	_, ok := os.LookupEnv("NETWORK_LATENCY")
+	assert.False(t, ok)
+}
+
+func TestInjectFaults(t *testing.T) {
+	var injected int32
+	RegisterFault("test-passing-fault", func(clients.ClientSets) Experiment {
+		return Experiment{Inject: func(ctx context.Context, state *State) error {
+			atomic.AddInt32(&injected, 1)
+			return nil
+		}}
+	})
+	RegisterFault("test-failing-fault", func(clients.ClientSets) Experiment {
+		return Experiment{Inject: func(ctx context.Context, state *State) error {
+			atomic.AddInt32(&injected, 1)
+			return errors.New("helper pod failed")
+		}}
+	})
+
+	composite, err := newCompositeFaults(clients.ClientSets{}, "[{name: test-passing-fault}, {name: test-failing-fault}]")
+	assert.NoError(t, err)
+	state := &State{ChaosDetails: &types.ChaosDetails{}, ResultDetails: &types.ResultDetails{}, EventsDetails: &types.EventDetails{}}
+	for _, f := range composite {
+		assert.NoError(t, f.prepare(context.Background(), state))
+	}
+
+	err = injectFaults(context.Background(), state, composite)
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), injected)
+	assert.Len(t, state.ChaosDetails.Faults, 2)
+	assert.Equal(t, faultCompleted, state.ChaosDetails.Faults[0].Phase)
+	assert.Equal(t, faultError, state.ChaosDetails.Faults[1].Phase)
+	assert.Equal(t, "helper pod failed", state.ChaosDetails.Faults[1].FailStep)
+
+	_, err = newCompositeFaults(clients.ClientSets{}, "[{name: unknown-fault}]")
+	assert.Error(t, err)
+	_, err = newCompositeFaults(clients.ClientSets{}, "[{name: test-passing-fault}, {name: test-passing-fault}]")
+	assert.Error(t, err)
+}
+
+func TestInjectFaultsAbort(t *testing.T) {
+	signChan := make(chan os.Signal, 1)
+	defer func(original func() (<-chan os.Signal, func())) { abortSignal = original }(abortSignal)
+	abortSignal = func() (<-chan os.Signal, func()) { return signChan, func() {} }
+
+	// the faults which watch the abort signal themselves are never running together
+	var running, injected, maxRunning int32
+	abortingFault := func(clients.ClientSets) Experiment {
+		return Experiment{AbortWithoutExit: true, Inject: func(ctx context.Context, state *State) error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			atomic.AddInt32(&injected, 1)
+			if n > atomic.LoadInt32(&maxRunning) {
+				atomic.StoreInt32(&maxRunning, n)
+			}
+			signChan <- syscall.SIGTERM
+			<-ctx.Done()
+			return nil
+		}}
+	}
+	RegisterFault("test-aborting-fault-1", abortingFault)
+	RegisterFault("test-aborting-fault-2", abortingFault)
+
+	composite, err := newCompositeFaults(clients.ClientSets{}, "[{name: test-aborting-fault-1}, {name: test-aborting-fault-2}]")
+	require.NoError(t, err)
+	state := &State{ChaosDetails: &types.ChaosDetails{}, ResultDetails: &types.ResultDetails{}, EventsDetails: &types.EventDetails{}}
+	for _, f := range composite {
+		require.NoError(t, f.prepare(context.Background(), state))
+	}
+
+	start := time.Now()
+	err = injectFaults(context.Background(), state, composite)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, cerrors.ErrorTypeExperimentAborted, cerrors.GetErrorType(err), err)
+	assert.Equal(t, int32(1), maxRunning)
+	assert.Equal(t, int32(1), injected)
+	assert.Equal(t, faultCompleted, state.ChaosDetails.Faults[0].Phase)
+	assert.Equal(t, faultError, state.ChaosDetails.Faults[1].Phase)
+}
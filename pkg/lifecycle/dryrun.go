@@ -0,0 +1,41 @@
+package lifecycle
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/result"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/common"
+)
+
+// dryRun derives the plan of the experiment and records it inside the chaosresult, without injecting the chaos
+// the post-chaos checks are skipped, as the target is left untouched
+func dryRun(ctx context.Context, state *State, experiment Experiment) {
+	chaosDetails := state.ChaosDetails
+
+	if experiment.DryRunSupported {
+		if err := inject(ctx, state, experiment); err != nil {
+			log.Errorf("Unable to derive the plan, err: %v", err)
+			result.RecordAfterFailure(chaosDetails, state.ResultDetails, err, state.Clients, state.EventsDetails)
+			return
+		}
+	} else {
+		log.Warnf("[DryRun]: %v experiment doesn't support the dry-run mode, skipping the chaos injection", chaosDetails.ExperimentName)
+		common.RecordPlanStep("skip", "chaos injection", map[string]string{"reason": "dry-run mode is not supported by the experiment"}, chaosDetails)
+	}
+
+	log.Infof("[The End]: Recording the plan of %v experiment inside the chaos result", chaosDetails.ExperimentName)
+	if err := result.ChaosResult(chaosDetails, state.Clients, state.ResultDetails, "EOT"); err != nil {
+		log.Errorf("Unable to update the chaosresult, err: %v", err)
+		result.RecordAfterFailure(chaosDetails, state.ResultDetails, err, state.Clients, state.EventsDetails)
+		return
+	}
+
+	if chaosDetails.EngineName != "" {
+		msg := chaosDetails.ExperimentName + " experiment dry-run has been completed with " + strconv.Itoa(len(chaosDetails.Plan)) + " planned step(s)"
+		types.SetEngineEventAttributes(state.EventsDetails, types.Summary, msg, "Normal", chaosDetails)
+		generateEvents(state, "ChaosEngine")
+	}
+}
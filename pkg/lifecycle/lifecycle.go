@@ -0,0 +1,318 @@
+package lifecycle
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/abort"
+	"github.com/litmuschaos/litmus-go/pkg/alerts"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/events"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/probe"
+	"github.com/litmuschaos/litmus-go/pkg/report"
+	"github.com/litmuschaos/litmus-go/pkg/result"
+	"github.com/litmuschaos/litmus-go/pkg/scenario"
+	"github.com/litmuschaos/litmus-go/pkg/schedule"
+	"github.com/litmuschaos/litmus-go/pkg/telemetry"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/common"
+	"github.com/litmuschaos/litmus-go/pkg/utils/tunables"
+	"github.com/litmuschaos/litmus-go/pkg/webhook"
+	"github.com/sirupsen/logrus"
+)
+
+// State contains the details shared between the runner and the experiment hooks
+type State struct {
+	Clients       clients.ClientSets
+	ChaosDetails  *types.ChaosDetails
+	ResultDetails *types.ResultDetails
+	EventsDetails *types.EventDetails
+}
+
+// Hook is an experiment specific step, executed by the runner
+type Hook func(ctx context.Context, state *State) error
+
+// Target is the entity under test, it is reported inside the pre and post chaos check events
+type Target struct {
+	Healthy   string
+	Unhealthy string
+}
+
+var (
+	// Application is the target of the application level experiments
+	Application = Target{Healthy: "AUT: Running", Unhealthy: "AUT: Not Running"}
+	// Node is the target of the node level experiments
+	Node = Target{Healthy: "NUT: Ready", Unhealthy: "NUT: Not Ready"}
+	// Instance is the target of the cloud and infra experiments
+	Instance = Target{Healthy: "IUT: Running", Unhealthy: "IUT: Not Running"}
+)
+
+// Experiment contains the hooks of an experiment, all of them are optional except Inject
+type Experiment struct {
+	// Target is the entity under test, it defaults to Application
+	Target Target
+	// DryRunSupported marks the inject hook as aware of the dry-run mode,
+	// it records the plan inside the chaos details instead of injecting the chaos
+	DryRunSupported bool
+	// AbortWithoutExit keeps the experiment running once the abort signal is received,
+	// so that the inject hook can revert the chaos before exiting
+	AbortWithoutExit bool
+	// RampTunable is the ENV of the intensity of the chaos, it enables the ramp mode of the experiment,
+	// where the chaos is injected once per INTENSITY_STEPS, with the ENV overridden by the step, and reverted between the steps
+	RampTunable string
+	// GetENV reads the experiment specific ENVs, the invalid values fail the experiment before the chaos injection
+	GetENV func() error
+	// Prepare derives the experiment specific attributes, before the chaos checks
+	Prepare Hook
+	// Info returns the experiment attributes, which are logged before the chaos
+	Info func(state *State) logrus.Fields
+	// PreChaosCheck verifies the health of the target before the chaos injection
+	PreChaosCheck Hook
+	// Inject injects the chaos
+	Inject Hook
+	// PostChaosCheck verifies the health of the target after the chaos injection
+	PostChaosCheck Hook
+}
+
+// Run executes the lifecycle of the given experiment
+// it updates the chaosresult, runs the checks and probes around the chaos injection and generates the events
+func Run(ctx context.Context, clients clients.ClientSets, experiment Experiment) {
+	resultDetails := types.ResultDetails{}
+	eventsDetails := types.EventDetails{}
+	chaosDetails := types.ChaosDetails{StartTime: time.Now()}
+
+	state := &State{
+		Clients:       clients,
+		ChaosDetails:  &chaosDetails,
+		ResultDetails: &resultDetails,
+		EventsDetails: &eventsDetails,
+	}
+	if experiment.Target == (Target{}) {
+		experiment.Target = Application
+	}
+
+	//Fetching all the ENV passed from the runner pod
+	log.Infof("[PreReq]: Getting the ENV for the %v experiment", os.Getenv("EXPERIMENT_NAME"))
+	var envErr error
+	if experiment.GetENV != nil {
+		envErr = experiment.GetENV()
+	}
+
+	// Initialize the chaos attributes
+	envErr = tunables.Join(envErr, types.InitialiseChaosVariables(&chaosDetails))
+	if envErr == nil {
+		envErr = checkRamp(experiment, &chaosDetails)
+	}
+
+	// the experiment fields are attached to the json logs
+	log.SetField(log.ExperimentField, chaosDetails.ExperimentName)
+	log.SetField(log.EngineField, chaosDetails.EngineName)
+	log.SetField(log.RunIDField, string(chaosDetails.ChaosUID))
+	setPhase(&chaosDetails, chaosDetails.Phase)
+
+	// Initialize Chaos Result Parameters
+	types.SetResultAttributes(&resultDetails, chaosDetails)
+
+	if s, ok := scenario.FromContext(ctx); ok {
+		// standalone mode, the probes are read from the scenario and the result is written to a local file
+		chaosDetails.ResultFile = s.ResultFile
+		chaosDetails.Probes = s.Probes
+		if err := types.InitializeProbesInChaosResultDetails(&resultDetails, s.Probes); err != nil {
+			log.Errorf("Unable to initialize the probes, err: %v", err)
+			return
+		}
+	} else if chaosDetails.EngineName != "" {
+		// Get values from chaosengine. Bail out upon error, as we haven't entered exp business logic yet
+		if err := common.GetValuesFromChaosEngine(&chaosDetails, clients, &resultDetails); err != nil {
+			log.Errorf("Unable to initialize the probes, err: %v", err)
+			return
+		}
+	}
+
+	//Updating the chaos result in the beginning of experiment
+	log.Infof("[PreReq]: Updating the chaos result of %v experiment (SOT)", chaosDetails.ExperimentName)
+	if err := result.ChaosResult(&chaosDetails, clients, &resultDetails, "SOT"); err != nil {
+		log.Errorf("Unable to create the chaosresult, err: %v", err)
+		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
+		return
+	}
+
+	// Set the chaos result uid
+	if err := result.SetResultUID(&resultDetails, clients, &chaosDetails); err != nil {
+		log.Errorf("Unable to set the result uid, err: %v", err)
+		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
+		return
+	}
+
+	// the report is written and the webhooks are notified once the run completes, irrespective of the verdict
+	webhook.Notify(webhook.Start, &chaosDetails, &resultDetails)
+	defer func() {
+		// the aborted runs are already reported by the abort watcher
+		if abort.Reason() != "" || resultDetails.Phase == v1alpha1.ResultPhaseStopped {
+			return
+		}
+		if err := report.Write(&chaosDetails, &resultDetails, clients, chaosDetails.StartTime); err != nil {
+			log.Errorf("Unable to write the report, err: %v", err)
+		}
+		webhook.Notify(webhook.Finish, &chaosDetails, &resultDetails)
+		if !webhook.Flush(time.Duration(chaosDetails.WebhookTimeout) * time.Second) {
+			log.Errorf("The webhooks are not notified within %vs", chaosDetails.WebhookTimeout)
+		}
+	}()
+
+	// the invalid tunables are reported inside the chaosresult, before any chaos is injected
+	if envErr != nil {
+		log.Errorf("Invalid experiment tunables, err: %v", envErr)
+		result.RecordAfterFailure(&chaosDetails, &resultDetails, envErr, clients, &eventsDetails)
+		return
+	}
+
+	// generating the event in chaosresult to mark the verdict as awaited
+	msg := "experiment: " + chaosDetails.ExperimentName + ", Result: Awaited"
+	types.SetResultEventAttributes(&eventsDetails, types.AwaitedVerdict, msg, "Normal", &resultDetails)
+	generateEvents(state, "ChaosResult")
+
+	if experiment.Prepare != nil {
+		if err := experiment.Prepare(ctx, state); err != nil {
+			log.Errorf("Unable to prepare the experiment, err: %v", err)
+			result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
+			return
+		}
+	}
+
+	//DISPLAY THE TARGET INFORMATION
+	if experiment.Info != nil {
+		log.InfoWithValues("The target information is as follows", experiment.Info(state))
+	}
+
+	// Calling AbortWatcher go routine, it will continuously watch for the abort signal and generate the required events and result
+	if experiment.AbortWithoutExit {
+		go common.AbortWatcherWithoutExit(chaosDetails.ExperimentName, clients, &resultDetails, &chaosDetails, &eventsDetails)
+	} else {
+		go common.AbortWatcher(chaosDetails.ExperimentName, clients, &resultDetails, &chaosDetails, &eventsDetails)
+	}
+
+	// the chaos is not injected outside the execution windows or during the blackout dates
+	if err := schedule.Check(&chaosDetails); err != nil {
+		log.Errorf("Unable to start the chaos, err: %v", err)
+		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
+		return
+	}
+
+	// the chaos is not injected while the matching alerts are firing, i.e, the system is already degraded
+	if err := alerts.Check(ctx, &chaosDetails); err != nil {
+		log.Errorf("Unable to start the chaos, err: %v", err)
+		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
+		return
+	}
+
+	//PRE-CHAOS CHECKS AND PROBES
+	if err := runChecks(ctx, state, experiment.PreChaosCheck, experiment.Target, types.PreChaosCheck, "PreChaos"); err != nil {
+		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
+		return
+	}
+
+	setPhase(&chaosDetails, types.ChaosInjectPhase)
+	if chaosDetails.DryRun {
+		dryRun(ctx, state, experiment)
+		return
+	}
+
+	webhook.Notify(webhook.Inject, &chaosDetails, &resultDetails)
+	injectionStart := time.Now()
+	// the experiment is aborted and the chaos is reverted, once the execution window is closed or a matching alert starts firing
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	go schedule.Watch(watchCtx, &chaosDetails)
+	go alerts.Watch(watchCtx, &chaosDetails)
+	err := inject(ctx, state, experiment)
+	stopWatch()
+	telemetry.RecordChaosDuration(time.Since(injectionStart))
+	webhook.Notify(webhook.Revert, &chaosDetails, &resultDetails)
+	if err != nil {
+		log.Errorf("Chaos injection failed, err: %v", err)
+		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
+		return
+	}
+
+	log.Infof("[Confirmation]: %v chaos has been injected successfully", chaosDetails.ExperimentName)
+	resultDetails.Verdict = v1alpha1.ResultVerdictPassed
+	setPhase(&chaosDetails, types.PostChaosPhase)
+
+	//POST-CHAOS CHECKS AND PROBES
+	if err := runChecks(ctx, state, experiment.PostChaosCheck, experiment.Target, types.PostChaosCheck, "PostChaos"); err != nil {
+		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
+		return
+	}
+
+	//Updating the chaosResult in the end of experiment
+	log.Infof("[The End]: Updating the chaos result of %v experiment (EOT)", chaosDetails.ExperimentName)
+	if err := result.ChaosResult(&chaosDetails, clients, &resultDetails, "EOT"); err != nil {
+		log.Errorf("Unable to update the chaosresult, err: %v", err)
+		result.RecordAfterFailure(&chaosDetails, &resultDetails, err, clients, &eventsDetails)
+		return
+	}
+
+	// generating the event in chaosresult to mark the verdict as pass/fail
+	msg = "experiment: " + chaosDetails.ExperimentName + ", Result: " + string(resultDetails.Verdict)
+	reason, eventType := types.GetChaosResultVerdictEvent(resultDetails.Verdict)
+	types.SetResultEventAttributes(&eventsDetails, reason, msg, eventType, &resultDetails)
+	generateEvents(state, "ChaosResult")
+
+	if chaosDetails.EngineName != "" {
+		msg := chaosDetails.ExperimentName + " experiment has been " + string(resultDetails.Verdict) + "ed"
+		types.SetEngineEventAttributes(&eventsDetails, types.Summary, msg, "Normal", &chaosDetails)
+		generateEvents(state, "ChaosEngine")
+	}
+}
+
+// runChecks runs the given check followed by the probes of the given phase
+// and generates the corresponding event inside the chaosengine
+func runChecks(ctx context.Context, state *State, check Hook, target Target, reason, phase string) error {
+	chaosDetails := state.ChaosDetails
+
+	if check != nil {
+		if err := check(ctx, state); err != nil {
+			log.Errorf("%v check failed, err: %v", phase, err)
+			types.SetEngineEventAttributes(state.EventsDetails, reason, target.Unhealthy, "Warning", chaosDetails)
+			generateEvents(state, "ChaosEngine")
+			return err
+		}
+	}
+
+	// marking the target as healthy, as we already checked the status of target under test
+	msg := common.GetStatusMessage(chaosDetails.DefaultHealthCheck, target.Healthy, "")
+
+	// the probes are defined inside the chaosengine or the standalone scenario
+	if len(state.ResultDetails.ProbeDetails) != 0 && !chaosDetails.DryRun {
+		if err := probe.RunProbes(ctx, chaosDetails, state.Clients, state.ResultDetails, phase, state.EventsDetails); err != nil {
+			log.Errorf("Probes Failed, err: %v", err)
+			msg = common.GetStatusMessage(chaosDetails.DefaultHealthCheck, target.Healthy, "Unsuccessful")
+			types.SetEngineEventAttributes(state.EventsDetails, reason, msg, "Warning", chaosDetails)
+			generateEvents(state, "ChaosEngine")
+			return err
+		}
+		msg = common.GetStatusMessage(chaosDetails.DefaultHealthCheck, target.Healthy, "Successful")
+	}
+
+	if chaosDetails.EngineName != "" {
+		types.SetEngineEventAttributes(state.EventsDetails, reason, msg, "Normal", chaosDetails)
+		generateEvents(state, "ChaosEngine")
+	}
+	return nil
+}
+
+// setPhase updates the phase of the experiment, along with the phase field of the logs
+func setPhase(chaosDetails *types.ChaosDetails, phase types.ExperimentPhase) {
+	chaosDetails.Phase = phase
+	log.SetField(log.PhaseField, string(phase))
+}
+
+// generateEvents generates the event inside the given kind of resource and logs the failure, if any
+func generateEvents(state *State, kind string) {
+	if err := events.GenerateEvents(state.EventsDetails, state.Clients, state.ChaosDetails, kind); err != nil {
+		log.Errorf("failed to create %v event inside %v, err: %v", state.EventsDetails.Reason, kind, err)
+	}
+}
@@ -0,0 +1,116 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/probe"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/stringutils"
+	"github.com/palantir/stacktrace"
+)
+
+// inject injects the chaos, in the steps of the ramp mode if the intensity steps are provided
+func inject(ctx context.Context, state *State, experiment Experiment) error {
+	if state.ChaosDetails.IntensitySteps == "" {
+		return experiment.Inject(ctx, state)
+	}
+	return rampInject(ctx, state, experiment)
+}
+
+// checkRamp verifies that the experiment supports the ramp mode and that all the intensity steps are valid tunables
+func checkRamp(experiment Experiment, chaosDetails *types.ChaosDetails) error {
+	if chaosDetails.IntensitySteps == "" {
+		return nil
+	}
+	if experiment.RampTunable == "" || experiment.GetENV == nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("ramp mode is not supported by the %v experiment", chaosDetails.ExperimentName)}
+	}
+	steps := stringutils.SplitList(chaosDetails.IntensitySteps)
+	duration := stepDuration(chaosDetails.ChaosDuration, len(steps))
+	for _, step := range steps {
+		if err := withEnv(rampEnv(experiment, step, duration), experiment.GetENV); err != nil {
+			return stacktrace.Propagate(err, "invalid intensity step %v", step)
+		}
+	}
+	// the tunables are read again, so that the experiment logs its own intensity till the ramp begins
+	return experiment.GetENV()
+}
+
+// rampInject injects the chaos once per intensity step, each step lasts for an equal share of the chaos duration
+// the onchaos and continuous probes are evaluated at the end of every step, and the ramp stops at the first step where any of them fails
+// the failed step is left to the post chaos probes, which fail the verdict.
+// Every step is a complete injection, the chaos is reverted at the end of the step and injected again with the next intensity,
+// so the target recovers for a few seconds between the steps, i.e, the time to evaluate the probes and to recreate the helpers.
+// The ramp time of the experiment is waited once before the first step and once after the last step, instead of around every step
+func rampInject(ctx context.Context, state *State, experiment Experiment) error {
+	chaosDetails := state.ChaosDetails
+	steps := stringutils.SplitList(chaosDetails.IntensitySteps)
+	duration := stepDuration(chaosDetails.ChaosDuration, len(steps))
+	chaosDetails.Ramp = &types.RampStatus{Tunable: experiment.RampTunable, Steps: steps}
+
+	// the ramp time is validated by the tunables of the experiment
+	rampTime, _ := strconv.Atoi(os.Getenv("RAMP_TIME"))
+	if !chaosDetails.DryRun {
+		waitRampTime(ctx, rampTime, "before injecting the first step")
+		defer waitRampTime(ctx, rampTime, "after reverting the last step")
+	}
+
+	// the onchaos probes run for the chaos duration, so it is scoped to the step
+	totalDuration := chaosDetails.ChaosDuration
+	chaosDetails.ChaosDuration = duration
+	defer func() { chaosDetails.ChaosDuration = totalDuration }()
+
+	for i, step := range steps {
+		log.Infof("[Ramp]: Injecting the step %d/%d with %v=%v for %vs", i+1, len(steps), experiment.RampTunable, step, duration)
+		if err := withEnv(rampEnv(experiment, step, duration), experiment.GetENV); err != nil {
+			return stacktrace.Propagate(err, "invalid intensity step %v", step)
+		}
+		probe.ResetOnChaosProbes(state.ResultDetails)
+		if err := experiment.Inject(ctx, state); err != nil {
+			return stacktrace.Propagate(err, "could not inject the intensity step %v", step)
+		}
+		if chaosDetails.DryRun || len(state.ResultDetails.ProbeDetails) == 0 {
+			chaosDetails.Ramp.Survived = step
+			continue
+		}
+
+		if err := probe.EvaluateStep(state.ResultDetails, chaosDetails.Delay, chaosDetails.Timeout); err != nil {
+			log.Errorf("[Ramp]: Probes failed at the step %d/%d with %v=%v, err: %v", i+1, len(steps), experiment.RampTunable, step, err)
+			chaosDetails.Ramp.Failed = step
+			return nil
+		}
+		chaosDetails.Ramp.Survived = step
+	}
+	return nil
+}
+
+// rampEnv returns the ENVs of the given intensity step, the ramp time is waited by the ramp itself
+func rampEnv(experiment Experiment, step string, duration int) map[string]string {
+	return map[string]string{experiment.RampTunable: step, "TOTAL_CHAOS_DURATION": strconv.Itoa(duration), "RAMP_TIME": "0"}
+}
+
+// waitRampTime waits for the given ramp time in seconds, unless the experiment is cancelled
+func waitRampTime(ctx context.Context, rampTime int, when string) {
+	if rampTime <= 0 {
+		return
+	}
+	log.Infof("[Ramp]: Waiting for the %vs ramp time %v", rampTime, when)
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Duration(rampTime) * time.Second):
+	}
+}
+
+// stepDuration splits the chaos duration equally across the steps, each step lasts for at least a second
+func stepDuration(chaosDuration, steps int) int {
+	if steps == 0 || chaosDuration/steps < 1 {
+		return 1
+	}
+	return chaosDuration / steps
+}
@@ -0,0 +1,87 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+func TestRampInject(t *testing.T) {
+	var latency string
+	var injected []string
+	experiment := Experiment{
+		RampTunable: "NETWORK_LATENCY",
+		GetENV: func() error {
+			latency = os.Getenv("NETWORK_LATENCY")
+			if latency == "invalid" {
+				return errors.New("invalid latency")
+			}
+			return nil
+		},
+	}
+	probes := []*types.ProbeDetails{{Name: "check-frontend", Mode: "OnChaos"}}
+	experiment.Inject = func(ctx context.Context, state *State) error {
+		injected = append(injected, latency)
+		assert.Equal(t, 20, state.ChaosDetails.ChaosDuration)
+		probes[0].HasProbeCompleted = true
+		if latency == "1000" {
+			probes[0].IsProbeFailedWithError = errors.New("frontend is down")
+		}
+		return nil
+	}
+
+	chaosDetails := &types.ChaosDetails{ExperimentName: "pod-network-latency", ChaosDuration: 60, IntensitySteps: "100,1000,2000", Delay: 1, Timeout: 1}
+	assert.NoError(t, checkRamp(experiment, chaosDetails))
+
+	state := &State{ChaosDetails: chaosDetails, ResultDetails: &types.ResultDetails{ProbeDetails: probes}}
+	assert.NoError(t, inject(context.Background(), state, experiment))
+	assert.Equal(t, []string{"100", "1000"}, injected)
+	assert.Equal(t, &types.RampStatus{Tunable: "NETWORK_LATENCY", Steps: []string{"100", "1000", "2000"}, Survived: "100", Failed: "1000"}, chaosDetails.Ramp)
+	assert.Equal(t, 60, chaosDetails.ChaosDuration)
+
+	chaosDetails.IntensitySteps = "100,invalid"
+	assert.Error(t, checkRamp(experiment, chaosDetails))
+	experiment.RampTunable = ""
+	chaosDetails.IntensitySteps = "100"
+	assert.Error(t, checkRamp(experiment, chaosDetails))
+}
+
+func TestRampInjectDuration(t *testing.T) {
+	t.Setenv("RAMP_TIME", "1")
+	var rampTimes []string
+	experiment := Experiment{
+		RampTunable: "CPU_LOAD",
+		GetENV: func() error {
+			rampTimes = append(rampTimes, os.Getenv("RAMP_TIME"))
+			return nil
+		},
+		Inject: func(ctx context.Context, state *State) error {
+			time.Sleep(100 * time.Millisecond)
+			return nil
+		},
+	}
+
+	chaosDetails := &types.ChaosDetails{ExperimentName: "pod-cpu-hog", ChaosDuration: 3, IntensitySteps: "25,50,100"}
+	state := &State{ChaosDetails: chaosDetails, ResultDetails: &types.ResultDetails{}}
+	start := time.Now()
+	assert.NoError(t, inject(context.Background(), state, experiment))
+
+	// the ramp time is waited once before and once after the steps, instead of twice per step by the experiment
+	elapsed := time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, 2300*time.Millisecond)
+	assert.Less(t, elapsed, 3*time.Second)
+	assert.Equal(t, []string{"0", "0", "0"}, rampTimes)
+	assert.Equal(t, "1", os.Getenv("RAMP_TIME"))
+}
+
+func TestStepDuration(t *testing.T) {
+	assert.Equal(t, 20, stepDuration(60, 3))
+	assert.Equal(t, 1, stepDuration(2, 3))
+	assert.Equal(t, 1, stepDuration(60, 0))
+}
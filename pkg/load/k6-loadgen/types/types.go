@@ -2,21 +2,21 @@ package types
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName     string
-	EngineName         string
-	ChaosDuration      int
-	ChaosInterval      int
-	RampTime           int
-	AppNS              string
-	AppLabel           string
-	AppKind            string
-	ChaosNamespace     string
-	Timeout            int
-	Delay              int
-	PodsAffectedPerc   int
-	LIBImagePullPolicy string
-	LIBImage           string
-	ScriptSecretName   string
-	ScriptSecretKey    string
-	OTELMetricPrefix   string
+	ExperimentName     string `env:"EXPERIMENT_NAME"`
+	EngineName         string `env:"CHAOSENGINE"`
+	ChaosDuration      int    `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	ChaosInterval      int    `env:"CHAOS_INTERVAL" default:"10" unit:"s" min:"0"`
+	RampTime           int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
+	AppNS              string `env:"APP_NAMESPACE"`
+	AppLabel           string `env:"APP_LABEL"`
+	AppKind            string `env:"APP_KIND"`
+	ChaosNamespace     string `env:"CHAOS_NAMESPACE" default:"litmus"`
+	Timeout            int    `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay              int    `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	PodsAffectedPerc   int    `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	LIBImagePullPolicy string `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	LIBImage           string `env:"LIB_IMAGE" default:"ghcr.io/grafana/k6-operator:latest-runner"`
+	ScriptSecretName   string `env:"SCRIPT_SECRET_NAME" default:"k6-script"`
+	ScriptSecretKey    string `env:"SCRIPT_SECRET_KEY" default:"script.js"`
+	OTELMetricPrefix   string `env:"OTEL_METRIC_PREFIX" default:"k6_"`
 }
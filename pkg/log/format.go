@@ -0,0 +1,118 @@
+package log
+
+import (
+	"context"
+	"sync"
+
+	logrus "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LogFormatENV selects the format of the logs, it is either text (default) or json
+const LogFormatENV = "LOG_FORMAT"
+
+// the supported log formats
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// the keys of the fields attached to every json log entry
+const (
+	ExperimentField = "experiment"
+	EngineField     = "engine"
+	RunIDField      = "run_id"
+	PhaseField      = "phase"
+	TargetField     = "target"
+	TraceIDField    = "trace_id"
+	SpanIDField     = "span_id"
+)
+
+var (
+	mu sync.RWMutex
+	// fields contains the experiment fields, which are attached to every json log entry
+	fields = logrus.Fields{}
+	// traceContext carries the span of the run, its trace ID is attached to every json log entry
+	traceContext = context.Background()
+)
+
+// SetFormat sets the format of the logs
+// the json format attaches the experiment fields and the trace ID of the run to every entry, along with the span ID
+// of the entries logged with a context, so that the experiment and helper logs can be joined with their traces
+func SetFormat(format string) {
+	switch format {
+	case FormatJSON:
+		logrus.SetFormatter(&logrus.JSONFormatter{})
+		logrus.AddHook(fieldsHook{})
+	default:
+		logrus.SetFormatter(&logrus.TextFormatter{
+			FullTimestamp:          true,
+			DisableSorting:         true,
+			DisableLevelTruncation: true,
+		})
+		if format != "" && format != FormatText {
+			Warnf("Unsupported log format %q, falling back to %s", format, FormatText)
+		}
+	}
+}
+
+// SetField sets the given experiment field, the empty values are removed
+// the fields are process wide, the fields of a single entry, such as the target, are passed along with the entry
+func SetField(key string, value string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if value == "" {
+		delete(fields, key)
+		return
+	}
+	fields[key] = value
+}
+
+// SetTraceContext sets the context carrying the span of the run
+func SetTraceContext(ctx context.Context) {
+	mu.Lock()
+	defer mu.Unlock()
+	traceContext = ctx
+}
+
+// WithContext returns the logger of the given context, its entries carry the trace and span IDs of the span inside the context
+func WithContext(ctx context.Context) *logrus.Entry {
+	return logrus.WithContext(ctx)
+}
+
+// InfoWithTarget log the entries about the given target, such as its injection and revert
+// It also print the extra key values pairs, along with the target and the span ID of the given context
+func InfoWithTarget(ctx context.Context, target string, msg string, val map[string]interface{}) {
+	WithContext(ctx).WithField(TargetField, target).WithFields(val).Info(msg)
+}
+
+// fieldsHook attaches the experiment fields and the trace and span IDs to the log entries
+type fieldsHook struct{}
+
+func (fieldsHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (fieldsHook) Fire(entry *logrus.Entry) error {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	for k, v := range fields {
+		if _, ok := entry.Data[k]; !ok {
+			entry.Data[k] = v
+		}
+	}
+
+	// the span ID is attached only to the entries logged with a context, as the span of the run doesn't change
+	if entry.Context != nil {
+		if sc := trace.SpanContextFromContext(entry.Context); sc.IsValid() {
+			entry.Data[TraceIDField] = sc.TraceID().String()
+			entry.Data[SpanIDField] = sc.SpanID().String()
+			return nil
+		}
+	}
+	if sc := trace.SpanContextFromContext(traceContext); sc.IsValid() {
+		entry.Data[TraceIDField] = sc.TraceID().String()
+	}
+	return nil
+}
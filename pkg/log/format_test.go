@@ -0,0 +1,81 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	logrus "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestJSONFormat(t *testing.T) {
+	var out bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&out)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	logger.AddHook(fieldsHook{})
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	SetTraceContext(trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID})))
+	SetField(ExperimentField, "pod-delete")
+	SetField(PhaseField, "ChaosInject")
+	SetField(TargetField, "")
+	t.Cleanup(func() {
+		SetTraceContext(context.Background())
+		SetField(ExperimentField, "")
+		SetField(PhaseField, "")
+	})
+
+	logger.Info("[Chaos]: Waiting for 60s")
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entry))
+	assert.Equal(t, "[Chaos]: Waiting for 60s", entry["msg"])
+	assert.Equal(t, "pod-delete", entry[ExperimentField])
+	assert.Equal(t, "ChaosInject", entry[PhaseField])
+	assert.Equal(t, traceID.String(), entry[TraceIDField])
+	assert.NotContains(t, entry, SpanIDField)
+	assert.NotContains(t, entry, TargetField)
+
+	// the span and the target are scoped to the entry
+	out.Reset()
+	childSpanID, _ := trace.SpanIDFromHex("b7ad6b7169203331")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: childSpanID}))
+	logger.WithContext(ctx).WithField(TargetField, "pod/nginx-1").Info("[Chaos]: Deleting the pod")
+
+	entry = map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entry))
+	assert.Equal(t, traceID.String(), entry[TraceIDField])
+	assert.Equal(t, childSpanID.String(), entry[SpanIDField])
+	assert.Equal(t, "pod/nginx-1", entry[TargetField])
+}
+
+func TestInfoWithTarget(t *testing.T) {
+	var out bytes.Buffer
+	logrus.SetOutput(&out)
+	logrus.SetFormatter(&logrus.JSONFormatter{})
+	t.Cleanup(func() {
+		logrus.SetOutput(os.Stderr)
+		logrus.SetFormatter(&logrus.TextFormatter{})
+	})
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("b7ad6b7169203331")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}))
+	logrus.AddHook(fieldsHook{})
+	t.Cleanup(func() { logrus.StandardLogger().ReplaceHooks(logrus.LevelHooks{}) })
+
+	InfoWithTarget(ctx, "pod/nginx-1", "successfully injected chaos on target", logrus.Fields{"Container": "nginx"})
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entry))
+	assert.Equal(t, "pod/nginx-1", entry[TargetField])
+	assert.Equal(t, spanID.String(), entry[SpanIDField])
+	assert.Equal(t, "nginx", entry["Container"])
+}
@@ -363,7 +363,7 @@ loop:
 			time.Sleep(probeTimeout.ProbePollingInterval)
 		}
 	}
-	// if experiment fails and stopOnfailure is provided as true then it will patch the chaosengine for abort
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
 	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
 	// and failed the experiment in the end
 	if isExperimentFailed && probe.RunProperties.StopOnFailure {
@@ -433,7 +433,7 @@ loop:
 			}
 		}
 	}
-	// if experiment fails and stopOnfailure is provided as true then it will patch the chaosengine for abort
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
 	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
 	// and failed the experiment in the end
 	if isExperimentFailed && probe.RunProperties.StopOnFailure {
@@ -503,7 +503,7 @@ loop:
 			}
 		}
 	}
-	// if experiment fails and stopOnfailure is provided as true then it will patch the chaosengine for abort
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
 	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
 	// and failed the experiment in the end
 	if isExperimentFailed && probe.RunProperties.StopOnFailure {
@@ -560,7 +560,7 @@ loop:
 			time.Sleep(probeTimeout.ProbePollingInterval)
 		}
 	}
-	// if experiment fails and stopOnfailure is provided as true then it will patch the chaosengine for abort
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
 	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
 	// and failed the experiment in the end
 	if isExperimentFailed && probe.RunProperties.StopOnFailure {
@@ -0,0 +1,605 @@
+package probe
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/math"
+	cmp "github.com/litmuschaos/litmus-go/pkg/probe/comparator"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/retry"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/dns/dnsmessage"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// defaultDNSProbeTimeout is the resolution timeout of the dns probe, if the probeTimeout is not provided
+	defaultDNSProbeTimeout = 5 * time.Second
+	// resolvConf contains the cluster resolver, which is used if no server is provided
+	resolvConf = "/etc/resolv.conf"
+)
+
+// dnsRecordTypes are the record types supported by the dns probe
+var dnsRecordTypes = map[string]dnsmessage.Type{
+	"A":     dnsmessage.TypeA,
+	"AAAA":  dnsmessage.TypeAAAA,
+	"SRV":   dnsmessage.TypeSRV,
+	"CNAME": dnsmessage.TypeCNAME,
+}
+
+// dnsRCodes are the names of the response codes, as reported by dig
+var dnsRCodes = map[dnsmessage.RCode]string{
+	dnsmessage.RCodeSuccess:        "NOERROR",
+	dnsmessage.RCodeFormatError:    "FORMERR",
+	dnsmessage.RCodeServerFailure:  "SERVFAIL",
+	dnsmessage.RCodeNameError:      "NXDOMAIN",
+	dnsmessage.RCodeNotImplemented: "NOTIMP",
+	dnsmessage.RCodeRefused:        "REFUSED",
+}
+
+// dnsProbeInputs contains the inputs of the dns probe
+type dnsProbeInputs struct {
+	// Hostname is resolved as a fully qualified name, such as orders.default.svc.cluster.local, it supports the templates
+	Hostname string `json:"hostname"`
+	// RecordType is one of A, AAAA, SRV and CNAME, it defaults to A
+	RecordType string `json:"recordType,omitempty"`
+	// Server is the host:port of the dns server, it defaults to the nameserver of the cluster resolver
+	Server string `json:"server,omitempty"`
+	// RCode compares the response code, by its name such as NXDOMAIN or by its number, it defaults to NOERROR
+	RCode *v1alpha1.ComparatorInfo `json:"rcode,omitempty"`
+	// Answers compares the sorted comma separated answers of the record type with the string criteria, or their count with the int criteria
+	// the SRV answers are formatted as priority weight port target, as by dig
+	Answers *v1alpha1.ComparatorInfo `json:"answers,omitempty"`
+	// Latency compares the resolution latency in milliseconds with the float criteria
+	Latency *v1alpha1.ComparatorInfo `json:"latency,omitempty"`
+}
+
+// dnsResponse is the outcome of the resolution
+type dnsResponse struct {
+	rcode   string
+	answers []string
+	latency time.Duration
+}
+
+// prepareDNSProbe contains the steps to prepare the dns probe
+// dns probe can be used to add the probe which will resolve the hostname and match the response code, the answers and the latency
+func prepareDNSProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails, phase string) error {
+
+	switch strings.ToLower(phase) {
+	case "prechaos":
+		if err := preChaosDNSProbe(probe, resultDetails, clients, chaosDetails); err != nil {
+			return err
+		}
+	case "postchaos":
+		if err := postChaosDNSProbe(probe, resultDetails, chaosDetails.Delay, chaosDetails.Timeout); err != nil {
+			return err
+		}
+	case "duringchaos":
+		onChaosDNSProbe(probe, resultDetails, clients, chaosDetails)
+	default:
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeDNSProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("phase '%s' not supported in the dns probe", phase)}
+	}
+	return nil
+}
+
+// parseDNSProbeInputs parses the inputs of the dns probe from the data field and fills in the defaults
+func parseDNSProbeInputs(probe v1alpha1.ProbeAttributes) (*dnsProbeInputs, error) {
+	inputs := &dnsProbeInputs{}
+	if err := yaml.UnmarshalStrict([]byte(probe.Data), inputs); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeDNSProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("failed to parse the dns probe inputs: %s", err.Error())}
+	}
+	if inputs.Hostname == "" {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeDNSProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: "hostname is required in the dns probe"}
+	}
+	inputs.RecordType = strings.ToUpper(inputs.RecordType)
+	if inputs.RecordType == "" {
+		inputs.RecordType = "A"
+	}
+	if _, ok := dnsRecordTypes[inputs.RecordType]; !ok {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeDNSProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("record type '%s' not supported in the dns probe", inputs.RecordType)}
+	}
+	if inputs.RCode == nil {
+		inputs.RCode = &v1alpha1.ComparatorInfo{Type: "string", Criteria: "equal", Value: "NOERROR"}
+	}
+	if strings.ToLower(inputs.RCode.Type) != "int" {
+		inputs.RCode.Value = strings.ToUpper(inputs.RCode.Value)
+	}
+	return inputs, nil
+}
+
+// triggerDNSProbe resolves the hostname and verify the response code, the answers and the latency to follow the specified criteria
+func triggerDNSProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	inputs, err := parseDNSProbeInputs(probe)
+	if err != nil {
+		return err
+	}
+	// It parses the templated hostname and return normal string
+	// if hostname doesn't have template, it will return the same hostname
+	if inputs.Hostname, err = parseCommand(inputs.Hostname, resultDetails); err != nil {
+		return err
+	}
+	if inputs.Server == "" {
+		if inputs.Server, err = clusterNameserver(resolvConf); err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeDNSProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+		}
+	}
+
+	timeout := probeTimeout.ProbeTimeout
+	if timeout == 0 {
+		timeout = defaultDNSProbeTimeout
+	}
+
+	log.InfoWithValues("[Probe]: DNS query informations", logrus.Fields{
+		"Name":            probe.Name,
+		"Hostname":        inputs.Hostname,
+		"RecordType":      inputs.RecordType,
+		"Server":          inputs.Server,
+		"RCode":           inputs.RCode,
+		"Answers":         inputs.Answers,
+		"Latency":         inputs.Latency,
+		"ResponseTimeout": timeout,
+	})
+
+	var description string
+
+	// it will retry for some retry count, in each iteration of try it contains following things
+	// it contains a timeout per iteration of retry. if the timeout expires without success then it will go to next try
+	// for a timeout, it will resolve the hostname, if it fails wait for the interval and again resolve it until timeout expires
+	if err := retry.Times(uint(getAttempts(probe.RunProperties.Attempt, probe.RunProperties.Retry))).
+		Wait(probeTimeout.Interval).
+		Try(func(attempt uint) error {
+			response, err := resolveDNS(inputs, timeout)
+			if err != nil {
+				// the unreachable or unresponsive server is treated as the failure instead of error
+				// so it can be handled with stopOnFailure config
+				var netErr net.Error
+				if errors.As(err, &netErr) {
+					return cerrors.Error{ErrorCode: cerrors.FailureTypeDNSProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+				}
+				return cerrors.Error{ErrorCode: cerrors.ErrorTypeDNSProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+			}
+			rc := getAndIncrementRunCount(resultDetails, probe.Name)
+
+			ms := strconv.FormatFloat(float64(response.latency.Microseconds())/1000, 'f', 3, 64)
+			answers := strings.Join(response.answers, ",")
+			rcode := response.rcode
+			if strings.ToLower(inputs.RCode.Type) == "int" {
+				rcode = strconv.Itoa(int(dnsRCodeByName(response.rcode)))
+			}
+
+			// comparing the response code, the answers and the latency with the expected criteria
+			comparisons := []struct {
+				field      string
+				actual     string
+				comparator *v1alpha1.ComparatorInfo
+			}{
+				{field: "rcode", actual: rcode, comparator: inputs.RCode},
+				{field: "answers", actual: answers, comparator: inputs.Answers},
+				{field: "latency", actual: ms, comparator: inputs.Latency},
+			}
+			for _, c := range comparisons {
+				if c.comparator == nil {
+					continue
+				}
+				actual := c.actual
+				if c.field == "answers" && strings.ToLower(c.comparator.Type) == "int" {
+					actual = strconv.Itoa(len(response.answers))
+				}
+				if err := validateDNSResponse(*c.comparator, c.field, probe.Name, probe.RunProperties.Verbosity, actual, rc); err != nil {
+					log.Errorf("The %v dns probe has Failed, err: %v", probe.Name, err)
+					return err
+				}
+			}
+			description = fmt.Sprintf("The hostname %s did resolve as expected. RCode: '%s'. Answers: '%s'. Latency: %sms", inputs.Hostname, response.rcode, answers, ms)
+			return nil
+		}); err != nil {
+		return err
+	}
+	setProbeDescription(resultDetails, probe, description)
+	return nil
+}
+
+// validateDNSResponse validate the field of the response to specified comparison operation
+// it supports int, float, string operands, the latency is compared as float
+func validateDNSResponse(comparator v1alpha1.ComparatorInfo, field, probeName, probeVerbosity string, value string, rc int) error {
+
+	compare := cmp.RunCount(rc).
+		FirstValue(value).
+		SecondValue(comparator.Value).
+		Criteria(comparator.Criteria).
+		ProbeName(probeName).
+		ProbeVerbosity(probeVerbosity)
+
+	comparatorType := strings.ToLower(comparator.Type)
+	if field == "latency" && comparatorType == "" {
+		comparatorType = "float"
+	}
+	switch comparatorType {
+	case "int":
+		return compare.CompareInt(cerrors.FailureTypeDNSProbe)
+	case "float":
+		return compare.CompareFloat(cerrors.FailureTypeDNSProbe)
+	case "string", "":
+		return compare.CompareString(cerrors.FailureTypeDNSProbe)
+	}
+	return cerrors.Error{ErrorCode: cerrors.ErrorTypeDNSProbe, Target: fmt.Sprintf("{name: %v}", probeName), Reason: fmt.Sprintf("comparator type '%s' not supported for the %s in the dns probe", comparator.Type, field)}
+}
+
+// resolveDNS queries the server for the records of the hostname, the query is retried over tcp if the udp response is truncated
+func resolveDNS(inputs *dnsProbeInputs, timeout time.Duration) (*dnsResponse, error) {
+	hostname := inputs.Hostname
+	if !strings.HasSuffix(hostname, ".") {
+		hostname += "."
+	}
+	name, err := dnsmessage.NewName(hostname)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hostname %q: %v", inputs.Hostname, err)
+	}
+	recordType := dnsRecordTypes[inputs.RecordType]
+	query := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: uint16(rand.Intn(1 << 16)), RecursionDesired: true},
+		Questions: []dnsmessage.Question{{Name: name, Type: recordType, Class: dnsmessage.ClassINET}},
+	}
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("unable to pack the query: %v", err)
+	}
+
+	start := time.Now()
+	data, err := exchangeDNS("udp", inputs.Server, packed, query.ID, timeout)
+	if err != nil {
+		return nil, err
+	}
+	var response dnsmessage.Message
+	if err := response.Unpack(data); err != nil {
+		return nil, fmt.Errorf("unable to parse the response: %v", err)
+	}
+	if response.Truncated {
+		if data, err = exchangeDNS("tcp", inputs.Server, packed, query.ID, timeout-time.Since(start)); err != nil {
+			return nil, err
+		}
+		if err := response.Unpack(data); err != nil {
+			return nil, fmt.Errorf("unable to parse the response: %v", err)
+		}
+	}
+	latency := time.Since(start)
+
+	rcode, ok := dnsRCodes[response.RCode]
+	if !ok {
+		rcode = strconv.Itoa(int(response.RCode))
+	}
+	var answers []string
+	for _, answer := range response.Answers {
+		if answer.Header.Type != recordType {
+			continue
+		}
+		switch body := answer.Body.(type) {
+		case *dnsmessage.AResource:
+			answers = append(answers, net.IP(body.A[:]).String())
+		case *dnsmessage.AAAAResource:
+			answers = append(answers, net.IP(body.AAAA[:]).String())
+		case *dnsmessage.CNAMEResource:
+			answers = append(answers, strings.TrimSuffix(body.CNAME.String(), "."))
+		case *dnsmessage.SRVResource:
+			answers = append(answers, fmt.Sprintf("%d %d %d %s", body.Priority, body.Weight, body.Port, strings.TrimSuffix(body.Target.String(), ".")))
+		}
+	}
+	sort.Strings(answers)
+	return &dnsResponse{rcode: rcode, answers: answers, latency: latency}, nil
+}
+
+// exchangeDNS sends the query to the server and returns the response with the same id
+// the tcp messages are prefixed with their length
+func exchangeDNS(network, server string, query []byte, id uint16, timeout time.Duration) ([]byte, error) {
+	conn, err := net.DialTimeout(network, server, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+
+	if network == "tcp" {
+		query = append(binary.BigEndian.AppendUint16(nil, uint16(len(query))), query...)
+	}
+	if _, err := conn.Write(query); err != nil {
+		return nil, err
+	}
+
+	for {
+		var data []byte
+		if network == "tcp" {
+			length := make([]byte, 2)
+			if _, err := io.ReadFull(conn, length); err != nil {
+				return nil, err
+			}
+			data = make([]byte, binary.BigEndian.Uint16(length))
+			if _, err := io.ReadFull(conn, data); err != nil {
+				return nil, err
+			}
+		} else {
+			buf := make([]byte, 65535)
+			n, err := conn.Read(buf)
+			if err != nil {
+				return nil, err
+			}
+			data = buf[:n]
+		}
+		// the stale responses of the earlier queries are skipped
+		if len(data) >= 2 && binary.BigEndian.Uint16(data) == id {
+			return data, nil
+		}
+	}
+}
+
+// clusterNameserver returns the first nameserver of the resolv.conf, along with the dns port
+func clusterNameserver(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the cluster resolver: %v", err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			return net.JoinHostPort(fields[1], "53"), nil
+		}
+	}
+	return "", fmt.Errorf("no nameserver found inside %s", path)
+}
+
+// dnsRCodeByName returns the response code of the given name
+func dnsRCodeByName(name string) dnsmessage.RCode {
+	for rcode, n := range dnsRCodes {
+		if n == name {
+			return rcode
+		}
+	}
+	code, _ := strconv.Atoi(name)
+	return dnsmessage.RCode(code)
+}
+
+// triggerContinuousDNSProbe trigger the continuous dns probes
+func triggerContinuousDNSProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosresult *types.ResultDetails, chaosDetails *types.ChaosDetails) {
+	probeTimeout := getProbeTimeouts(probe.Name, chaosresult.ProbeDetails)
+	var isExperimentFailed bool
+	// waiting for initial delay
+	if probeTimeout.InitialDelay != 0 {
+		log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+		time.Sleep(probeTimeout.InitialDelay)
+	}
+
+	// it triggers the dns probe for the entire duration of chaos and it fails, if any error encounter
+	// it marked the error for the probes, if any
+loop:
+	for {
+		select {
+		case <-chaosDetails.ProbeContext.Ctx.Done():
+			log.Infof("Stopping %s continuous Probe", probe.Name)
+			for index := range chaosresult.ProbeDetails {
+				if chaosresult.ProbeDetails[index].Name == probe.Name {
+					chaosresult.ProbeDetails[index].HasProbeCompleted = true
+				}
+			}
+			break loop
+		default:
+			err = triggerDNSProbe(probe, chaosresult)
+			// record the error inside the probeDetails, we are maintaining a dedicated variable for the err, inside probeDetails
+			if err != nil {
+				err = addProbePhase(err, string(chaosDetails.Phase))
+				for index := range chaosresult.ProbeDetails {
+					if chaosresult.ProbeDetails[index].Name == probe.Name {
+						chaosresult.ProbeDetails[index].IsProbeFailedWithError = err
+						chaosresult.ProbeDetails[index].HasProbeCompleted = true
+						chaosresult.ProbeDetails[index].Status.Description = getDescription(err)
+						log.Errorf("The %v dns probe has been Failed, err: %v", probe.Name, err)
+						isExperimentFailed = true
+						break loop
+					}
+				}
+			}
+			// waiting for the probe polling interval
+			time.Sleep(probeTimeout.ProbePollingInterval)
+		}
+	}
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
+	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
+	// and failed the experiment in the end
+	if isExperimentFailed && probe.RunProperties.StopOnFailure {
+		if err := stopChaosEngine(probe, clients, chaosresult, chaosDetails); err != nil {
+			log.Errorf("Unable to patch chaosengine to stop, err: %v", err)
+		}
+	}
+}
+
+// preChaosDNSProbe trigger the dns probe for prechaos phase
+func preChaosDNSProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	switch probe.Mode {
+	case "SOT", "Edge":
+
+		//DISPLAY THE DNS PROBE INFO
+		log.InfoWithValues("[Probe]: The dns probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PreChaos",
+		})
+
+		// waiting for initial delay
+		if probeTimeout.InitialDelay != 0 {
+			log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+			time.Sleep(probeTimeout.InitialDelay)
+		}
+		// trigger the dns probe
+		if err = triggerDNSProbe(probe, resultDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeDNSProbe {
+			return err
+		}
+
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		// it will update the status of all the unrun probes as well
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PreChaos"); err != nil {
+			return err
+		}
+	case "Continuous":
+
+		//DISPLAY THE DNS PROBE INFO
+		log.InfoWithValues("[Probe]: The dns probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PreChaos",
+		})
+
+		go triggerContinuousDNSProbe(probe, clients, resultDetails, chaosDetails)
+	}
+	return nil
+}
+
+// postChaosDNSProbe trigger the dns probe for postchaos phase
+func postChaosDNSProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, delay int, timeout int) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	switch probe.Mode {
+	case "EOT", "Edge":
+
+		//DISPLAY THE DNS PROBE INFO
+		log.InfoWithValues("[Probe]: The dns probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PostChaos",
+		})
+
+		// waiting for initial delay
+		if probeTimeout.InitialDelay != 0 {
+			log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+			time.Sleep(probeTimeout.InitialDelay)
+		}
+
+		// trigger the dns probe
+		if err = triggerDNSProbe(probe, resultDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeDNSProbe {
+			return err
+		}
+
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		// it will update the status of all the unrun probes as well
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PostChaos"); err != nil {
+			return err
+		}
+	case "Continuous", "OnChaos":
+		// it will check for the error, It will detect the error if any error encountered in probe during chaos
+		if err = checkForErrorInContinuousProbe(resultDetails, probe.Name, delay, timeout); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeDNSProbe && cerrors.GetErrorType(err) != cerrors.FailureTypeProbeTimeout {
+			return err
+		}
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PostChaos"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// triggerOnChaosDNSProbe trigger the onchaos dns probes
+func triggerOnChaosDNSProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosresult *types.ResultDetails, chaosDetails *types.ChaosDetails) {
+	probeTimeout := getProbeTimeouts(probe.Name, chaosresult.ProbeDetails)
+
+	var isExperimentFailed bool
+	duration := chaosDetails.ChaosDuration
+	// waiting for initial delay
+	if probeTimeout.InitialDelay != 0 {
+		log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+		time.Sleep(probeTimeout.InitialDelay)
+		duration = math.Maximum(0, duration-int(probeTimeout.InitialDelay.Seconds()))
+	}
+
+	endTime := time.After(time.Duration(duration) * time.Second)
+
+	// it trigger the dns probe for the entire duration of chaos and it fails, if any error encounter
+	// it marked the error for the probes, if any
+loop:
+	for {
+		select {
+		case <-endTime:
+			log.Infof("[Chaos]: Time is up for the %v probe", probe.Name)
+			endTime = nil
+			for index := range chaosresult.ProbeDetails {
+				if chaosresult.ProbeDetails[index].Name == probe.Name {
+					chaosresult.ProbeDetails[index].HasProbeCompleted = true
+				}
+			}
+			break loop
+		default:
+			err = triggerDNSProbe(probe, chaosresult)
+			// record the error inside the probeDetails, we are maintaining a dedicated variable for the err, inside probeDetails
+			if err != nil {
+				err = addProbePhase(err, string(chaosDetails.Phase))
+				for index := range chaosresult.ProbeDetails {
+					if chaosresult.ProbeDetails[index].Name == probe.Name {
+						chaosresult.ProbeDetails[index].IsProbeFailedWithError = err
+						chaosresult.ProbeDetails[index].HasProbeCompleted = true
+						chaosresult.ProbeDetails[index].Status.Description = getDescription(err)
+						isExperimentFailed = true
+						break loop
+					}
+				}
+			}
+
+			select {
+			case <-chaosDetails.ProbeContext.Ctx.Done():
+				log.Infof("Stopping %s continuous Probe", probe.Name)
+				for index := range chaosresult.ProbeDetails {
+					if chaosresult.ProbeDetails[index].Name == probe.Name {
+						chaosresult.ProbeDetails[index].HasProbeCompleted = true
+					}
+				}
+				break loop
+			default:
+				// waiting for the probe polling interval
+				time.Sleep(probeTimeout.ProbePollingInterval)
+			}
+		}
+	}
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
+	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
+	// and failed the experiment in the end
+	if isExperimentFailed && probe.RunProperties.StopOnFailure {
+		if err := stopChaosEngine(probe, clients, chaosresult, chaosDetails); err != nil {
+			log.Errorf("unable to patch chaosengine to stop, err: %v", err)
+		}
+	}
+}
+
+// onChaosDNSProbe trigger the dns probe for DuringChaos phase
+func onChaosDNSProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) {
+
+	switch probe.Mode {
+	case "OnChaos":
+
+		//DISPLAY THE DNS PROBE INFO
+		log.InfoWithValues("[Probe]: The dns probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "DuringChaos",
+		})
+		go triggerOnChaosDNSProbe(probe, clients, resultDetails, chaosDetails)
+	}
+}
@@ -0,0 +1,90 @@
+package probe
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+// serveDNS answers the A queries of orders.default.svc.cluster.local and the SRV queries of _grpc._tcp.orders.default.svc.cluster.local
+func serveDNS(t *testing.T) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			var query dnsmessage.Message
+			if err := query.Unpack(buf[:n]); err != nil {
+				continue
+			}
+			q := query.Questions[0]
+			response := dnsmessage.Message{Header: dnsmessage.Header{ID: query.ID, Response: true, RCode: dnsmessage.RCodeNameError}, Questions: query.Questions}
+			header := dnsmessage.ResourceHeader{Name: q.Name, Type: q.Type, Class: q.Class, TTL: 30}
+			switch {
+			case q.Name.String() == "orders.default.svc.cluster.local." && q.Type == dnsmessage.TypeA:
+				response.RCode = dnsmessage.RCodeSuccess
+				response.Answers = []dnsmessage.Resource{
+					{Header: header, Body: &dnsmessage.AResource{A: [4]byte{10, 0, 0, 2}}},
+					{Header: header, Body: &dnsmessage.AResource{A: [4]byte{10, 0, 0, 1}}},
+				}
+			case q.Name.String() == "_grpc._tcp.orders.default.svc.cluster.local." && q.Type == dnsmessage.TypeSRV:
+				response.RCode = dnsmessage.RCodeSuccess
+				response.Answers = []dnsmessage.Resource{
+					{Header: header, Body: &dnsmessage.SRVResource{Priority: 0, Weight: 100, Port: 50051, Target: dnsmessage.MustNewName("orders.default.svc.cluster.local.")}},
+				}
+			}
+			packed, _ := response.Pack()
+			conn.WriteTo(packed, addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestTriggerDNSProbe(t *testing.T) {
+	server := serveDNS(t)
+
+	tests := []struct {
+		name    string
+		data    string
+		failure bool
+	}{
+		{name: "resolved", data: "hostname: orders.default.svc.cluster.local"},
+		{name: "answers", data: "hostname: orders.default.svc.cluster.local\nanswers:\n  criteria: matches\n  value: '^10\\.0\\.0\\.1,10\\.0\\.0\\.2$'"},
+		{name: "answers count", data: "hostname: orders.default.svc.cluster.local\nanswers:\n  type: int\n  criteria: ==\n  value: \"2\""},
+		{name: "answers mismatch", data: "hostname: orders.default.svc.cluster.local\nanswers:\n  criteria: contains\n  value: 10.0.0.3", failure: true},
+		{name: "srv", data: "hostname: _grpc._tcp.orders.default.svc.cluster.local\nrecordType: srv\nanswers:\n  criteria: equal\n  value: 0 100 50051 orders.default.svc.cluster.local"},
+		{name: "nxdomain", data: "hostname: payments.default.svc.cluster.local", failure: true},
+		{name: "expected nxdomain", data: "hostname: payments.default.svc.cluster.local\nrcode:\n  criteria: equal\n  value: nxdomain"},
+		{name: "latency", data: "hostname: orders.default.svc.cluster.local\nlatency:\n  criteria: <=\n  value: \"1000\""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe, resultDetails := newTestProbe("check-dns", "dnsProbe", "SOT", tt.data+"\nserver: "+server, types.ProbeTimeouts{ProbeTimeout: time.Second})
+			assertProbeError(t, triggerDNSProbe(probe, resultDetails), tt.failure, cerrors.FailureTypeDNSProbe)
+		})
+	}
+}
+
+func TestClusterNameserver(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resolv.conf")
+	require.NoError(t, os.WriteFile(path, []byte("search default.svc.cluster.local svc.cluster.local\nnameserver 10.96.0.10\noptions ndots:5\n"), 0644))
+	server, err := clusterNameserver(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.96.0.10:53", server)
+}
@@ -0,0 +1,703 @@
+package probe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/math"
+	cmp "github.com/litmuschaos/litmus-go/pkg/probe/comparator"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/httpauth"
+	"github.com/litmuschaos/litmus-go/pkg/utils/retry"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	// the health checking protos are linked, so that the health check doesn't require the server reflection
+	_ "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"sigs.k8s.io/yaml"
+)
+
+// healthCheckMethod is the method of the standard grpc health checking protocol, it is called if no method is provided
+const healthCheckMethod = "grpc.health.v1.Health/Check"
+
+// grpcProbeInputs contains the inputs of the grpc probe
+type grpcProbeInputs struct {
+	// Address is the host:port of the server, it supports the templates
+	Address string `json:"address"`
+	// Service is the service name sent inside the health check request
+	Service string `json:"service,omitempty"`
+	// Method is the unary method in the package.Service/Method format, it defaults to the health check
+	Method string `json:"method,omitempty"`
+	// Request is the JSON encoded request message
+	Request string `json:"request,omitempty"`
+	// DescriptorSet is the path of the protoset file of the method, the server reflection is used otherwise
+	DescriptorSet string `json:"descriptorSet,omitempty"`
+	// Metadata is sent along with the request, such as the authorization headers
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// TLS enables the TLS, the plaintext connection is used otherwise
+	TLS *httpauth.TLS `json:"tls,omitempty"`
+	// Code is the expected status code of the call, such as OK or NOT_FOUND, it defaults to OK
+	Code string `json:"code,omitempty"`
+	// Comparator compares the given field of the response, it defaults to SERVING status for the health check
+	Comparator *grpcProbeComparator `json:"comparator,omitempty"`
+}
+
+// grpcProbeComparator compares the field of the response, the field is a dot separated path with the proto names, such as order.state
+type grpcProbeComparator struct {
+	Field                   string `json:"field"`
+	v1alpha1.ComparatorInfo `json:",inline"`
+}
+
+// prepareGRPCProbe contains the steps to prepare the grpc probe
+// grpc probe can be used to add the probe which will call the health check or any other unary method and match the status or the response
+func prepareGRPCProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails, phase string) error {
+
+	switch strings.ToLower(phase) {
+	case "prechaos":
+		if err := preChaosGRPCProbe(probe, resultDetails, clients, chaosDetails); err != nil {
+			return err
+		}
+	case "postchaos":
+		if err := postChaosGRPCProbe(probe, resultDetails, chaosDetails.Delay, chaosDetails.Timeout); err != nil {
+			return err
+		}
+	case "duringchaos":
+		onChaosGRPCProbe(probe, resultDetails, clients, chaosDetails)
+	default:
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGRPCProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("phase '%s' not supported in the grpc probe", phase)}
+	}
+	return nil
+}
+
+// parseGRPCProbeInputs parses the inputs of the grpc probe from the data field and fills in the defaults
+func parseGRPCProbeInputs(probe v1alpha1.ProbeAttributes) (*grpcProbeInputs, error) {
+	inputs := &grpcProbeInputs{}
+	if err := yaml.UnmarshalStrict([]byte(probe.Data), inputs); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGRPCProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("failed to parse the grpc probe inputs: %s", err.Error())}
+	}
+	if inputs.Address == "" {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGRPCProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: "address is required in the grpc probe"}
+	}
+
+	inputs.Method = strings.TrimPrefix(inputs.Method, "/")
+	if inputs.Method == "" {
+		inputs.Method = healthCheckMethod
+	}
+	if inputs.Method == healthCheckMethod {
+		if inputs.Request == "" {
+			request, _ := json.Marshal(map[string]string{"service": inputs.Service})
+			inputs.Request = string(request)
+		}
+		if inputs.Comparator == nil {
+			inputs.Comparator = &grpcProbeComparator{Field: "status", ComparatorInfo: v1alpha1.ComparatorInfo{Type: "string", Criteria: "equal", Value: "SERVING"}}
+		}
+	}
+	if inputs.Code == "" {
+		inputs.Code = "OK"
+	}
+	if _, err := parseGRPCCode(inputs.Code); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGRPCProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+	}
+	return inputs, nil
+}
+
+// triggerGRPCProbe calls the grpc method and verify the status code and the response to follow the specified criteria
+func triggerGRPCProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	inputs, err := parseGRPCProbeInputs(probe)
+	if err != nil {
+		return err
+	}
+	// It parses the templated address and return normal string
+	// if address doesn't have template, it will return the same address
+	if inputs.Address, err = parseCommand(inputs.Address, resultDetails); err != nil {
+		return err
+	}
+
+	log.InfoWithValues("[Probe]: GRPC method informations", logrus.Fields{
+		"Name":            probe.Name,
+		"Address":         inputs.Address,
+		"Method":          inputs.Method,
+		"Code":            inputs.Code,
+		"Comparator":      inputs.Comparator,
+		"ResponseTimeout": probe.RunProperties.ProbeTimeout,
+	})
+
+	conn, err := dialGRPC(inputs)
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGRPCProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+	}
+	defer conn.Close()
+
+	var method protoreflect.MethodDescriptor
+	var description string
+
+	// it will retry for some retry count, in each iteration of try it contains following things
+	// it contains a timeout per iteration of retry. if the timeout expires without success then it will go to next try
+	// for a timeout, it will call the method, if it fails wait for the interval and again call the method until timeout expires
+	if err := retry.Times(uint(getAttempts(probe.RunProperties.Attempt, probe.RunProperties.Retry))).
+		Wait(probeTimeout.Interval).
+		Try(func(attempt uint) error {
+			ctx := context.Background()
+			if probeTimeout.ProbeTimeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, probeTimeout.ProbeTimeout)
+				defer cancel()
+			}
+
+			// the method is resolved once, the reflection is retried along with the call if the server is unavailable
+			if method == nil {
+				if method, err = resolveGRPCMethod(ctx, conn, inputs); err != nil {
+					if isGRPCConnectionError(err) {
+						return cerrors.Error{ErrorCode: cerrors.FailureTypeGRPCProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+					}
+					return cerrors.Error{ErrorCode: cerrors.ErrorTypeGRPCProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+				}
+			}
+
+			code, response, err := invokeGRPC(ctx, conn, method, inputs)
+			if err != nil {
+				return cerrors.Error{ErrorCode: cerrors.ErrorTypeGRPCProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+			}
+			rc := getAndIncrementRunCount(resultDetails, probe.Name)
+
+			// comparing the status code with the expected code
+			expected, _ := parseGRPCCode(inputs.Code)
+			if err = cmp.RunCount(rc).
+				FirstValue(code.String()).
+				SecondValue(expected.String()).
+				Criteria("equal").
+				ProbeName(probe.Name).
+				ProbeVerbosity(probe.RunProperties.Verbosity).
+				CompareString(cerrors.FailureTypeGRPCProbe); err != nil {
+				log.Errorf("The %v grpc probe has Failed, err: %v", probe.Name, err)
+				return err
+			}
+			description = fmt.Sprintf("The method %s did respond with correct status code. Actual code: '%s'. Expected code: '%s'", inputs.Method, code, expected)
+
+			// comparing the field of the response with the expected criteria
+			if code != codes.OK || inputs.Comparator == nil {
+				return nil
+			}
+			value, err := grpcResponseField(response, inputs.Comparator.Field)
+			if err != nil {
+				return cerrors.Error{ErrorCode: cerrors.ErrorTypeGRPCProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+			}
+			if description, err = validateGRPCResponse(inputs.Comparator.ComparatorInfo, probe.Name, probe.RunProperties.Verbosity, value, rc); err != nil {
+				log.Errorf("The %v grpc probe has Failed, err: %v", probe.Name, err)
+				return err
+			}
+			description = fmt.Sprintf("The method %s did respond with correct %s. %s", inputs.Method, inputs.Comparator.Field, description)
+			return nil
+		}); err != nil {
+		return err
+	}
+	setProbeDescription(resultDetails, probe, description)
+	return nil
+}
+
+// dialGRPC creates the client connection to the address, using the TLS or the plaintext credentials
+func dialGRPC(inputs *grpcProbeInputs) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if inputs.TLS != nil {
+		tlsConfig, err := inputs.TLS.Config()
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	return grpc.NewClient(inputs.Address, grpc.WithTransportCredentials(creds))
+}
+
+// resolveGRPCMethod derives the descriptor of the method, from the linked protos, the descriptor set or the server reflection
+func resolveGRPCMethod(ctx context.Context, conn *grpc.ClientConn, inputs *grpcProbeInputs) (protoreflect.MethodDescriptor, error) {
+	i := strings.LastIndex(inputs.Method, "/")
+	if i <= 0 || i == len(inputs.Method)-1 {
+		return nil, fmt.Errorf("invalid method %q, expected package.Service/Method", inputs.Method)
+	}
+	serviceName, methodName := protoreflect.FullName(inputs.Method[:i]), protoreflect.Name(inputs.Method[i+1:])
+
+	var files *protoregistry.Files
+	var err error
+	switch {
+	case inputs.DescriptorSet != "":
+		files, err = descriptorSetFiles(inputs.DescriptorSet)
+	default:
+		if _, err := protoregistry.GlobalFiles.FindDescriptorByName(serviceName); err == nil {
+			files = protoregistry.GlobalFiles
+		} else {
+			files, err = reflectionFiles(ctx, conn, serviceName)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	descriptor, err := files.FindDescriptorByName(serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find the %s service: %v", serviceName, err)
+	}
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", serviceName)
+	}
+	method := service.Methods().ByName(methodName)
+	if method == nil {
+		return nil, fmt.Errorf("unable to find the %s method inside the %s service", methodName, serviceName)
+	}
+	if method.IsStreamingClient() || method.IsStreamingServer() {
+		return nil, fmt.Errorf("%s is a streaming method, only the unary methods are supported", inputs.Method)
+	}
+	return method, nil
+}
+
+// descriptorSetFiles reads the files of the descriptor set, such as the output of protoc --descriptor_set_out --include_imports
+func descriptorSetFiles(path string) (*protoregistry.Files, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the descriptor set: %v", err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		return nil, fmt.Errorf("unable to parse the descriptor set %s: %v", path, err)
+	}
+	return buildFiles(set.File)
+}
+
+// reflectionFiles fetches the file of the service along with its dependencies, using the server reflection
+func reflectionFiles(ctx context.Context, conn *grpc.ClientConn, service protoreflect.FullName) (*protoregistry.Files, error) {
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.CloseSend()
+
+	if err := stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: string(service)},
+	}); err != nil {
+		return nil, err
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if errResp := resp.GetErrorResponse(); errResp != nil {
+		return nil, fmt.Errorf("server reflection failed for the %s service: %s", service, errResp.ErrorMessage)
+	}
+
+	var fds []*descriptorpb.FileDescriptorProto
+	for _, raw := range resp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+		fd := &descriptorpb.FileDescriptorProto{}
+		if err := proto.Unmarshal(raw, fd); err != nil {
+			return nil, fmt.Errorf("unable to parse the reflected file: %v", err)
+		}
+		fds = append(fds, fd)
+	}
+	return buildFiles(fds)
+}
+
+// buildFiles builds the registry out of the given files, the dependencies missing from them are resolved from the linked protos
+func buildFiles(fds []*descriptorpb.FileDescriptorProto) (*protoregistry.Files, error) {
+	byName := map[string]*descriptorpb.FileDescriptorProto{}
+	for _, fd := range fds {
+		byName[fd.GetName()] = fd
+	}
+
+	files := &protoregistry.Files{}
+	var register func(name string) error
+	register = func(name string) error {
+		if _, err := files.FindFileByPath(name); err == nil {
+			return nil
+		}
+		fd, ok := byName[name]
+		if !ok {
+			linked, err := protoregistry.GlobalFiles.FindFileByPath(name)
+			if err != nil {
+				return fmt.Errorf("the %s proto file is missing", name)
+			}
+			return files.RegisterFile(linked)
+		}
+		for _, dep := range fd.GetDependency() {
+			if err := register(dep); err != nil {
+				return err
+			}
+		}
+		file, err := protodesc.NewFile(fd, files)
+		if err != nil {
+			return fmt.Errorf("invalid %s proto file: %v", name, err)
+		}
+		return files.RegisterFile(file)
+	}
+
+	for _, fd := range fds {
+		if err := register(fd.GetName()); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// invokeGRPC calls the unary method with the request and the metadata of the probe
+// it returns the status code of the call along with the response, the error is returned only if the call couldn't be made
+func invokeGRPC(ctx context.Context, conn *grpc.ClientConn, method protoreflect.MethodDescriptor, inputs *grpcProbeInputs) (codes.Code, *dynamicpb.Message, error) {
+	request := dynamicpb.NewMessage(method.Input())
+	if inputs.Request != "" {
+		if err := protojson.Unmarshal([]byte(inputs.Request), request); err != nil {
+			return codes.Unknown, nil, fmt.Errorf("invalid request for the %s method: %v", inputs.Method, err)
+		}
+	}
+	if len(inputs.Metadata) != 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(inputs.Metadata))
+	}
+
+	response := dynamicpb.NewMessage(method.Output())
+	fullMethod := fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())
+	if err := conn.Invoke(ctx, fullMethod, request, response); err != nil {
+		return status.Code(err), nil, nil
+	}
+	return codes.OK, response, nil
+}
+
+// grpcResponseField returns the value of the dot separated field of the response, the unset fields have their default values
+func grpcResponseField(response *dynamicpb.Message, field string) (string, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(response)
+	if err != nil {
+		return "", fmt.Errorf("unable to encode the response: %v", err)
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", fmt.Errorf("unable to decode the response: %v", err)
+	}
+
+	for _, key := range strings.Split(field, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[key]; !ok {
+				return "", fmt.Errorf("field %s not found in the response", field)
+			}
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(v) {
+				return "", fmt.Errorf("field %s not found in the response", field)
+			}
+			value = v[index]
+		default:
+			return "", fmt.Errorf("field %s not found in the response", field)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case map[string]interface{}, []interface{}:
+		data, _ := json.Marshal(v)
+		return string(data), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// validateGRPCResponse validate the field of the response to specified comparison operation
+// it supports int, float, string operands
+func validateGRPCResponse(comparator v1alpha1.ComparatorInfo, probeName, probeVerbosity string, value string, rc int) (string, error) {
+
+	compare := cmp.RunCount(rc).
+		FirstValue(value).
+		SecondValue(comparator.Value).
+		Criteria(comparator.Criteria).
+		ProbeName(probeName).
+		ProbeVerbosity(probeVerbosity)
+
+	switch strings.ToLower(comparator.Type) {
+	case "int":
+		if err = compare.CompareInt(cerrors.FailureTypeGRPCProbe); err != nil {
+			return "", err
+		}
+	case "float":
+		if err = compare.CompareFloat(cerrors.FailureTypeGRPCProbe); err != nil {
+			return "", err
+		}
+	case "string", "":
+		if err = compare.CompareString(cerrors.FailureTypeGRPCProbe); err != nil {
+			return "", err
+		}
+	default:
+		return "", cerrors.Error{ErrorCode: cerrors.ErrorTypeGRPCProbe, Target: fmt.Sprintf("{name: %v}", probeName), Reason: fmt.Sprintf("comparator type '%s' not supported in the grpc probe", comparator.Type)}
+	}
+	return fmt.Sprintf("Actual value: '%s'. Expected value: '%s'", value, comparator.Value), nil
+}
+
+// parseGRPCCode parses the status code, either by its name, such as NOT_FOUND, or by its number
+func parseGRPCCode(s string) (codes.Code, error) {
+	var code codes.Code
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if _, err := strconv.Atoi(name); err != nil {
+		name = strconv.Quote(name)
+	}
+	if err := code.UnmarshalJSON([]byte(name)); err != nil {
+		return 0, fmt.Errorf("invalid grpc status code %q", s)
+	}
+	return code, nil
+}
+
+// isGRPCConnectionError checks whether the server is unreachable or didn't respond in time
+// these are treated as the probe failures instead of errors, so they can be handled with stopOnFailure config
+func isGRPCConnectionError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// triggerContinuousGRPCProbe trigger the continuous grpc probes
+func triggerContinuousGRPCProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosresult *types.ResultDetails, chaosDetails *types.ChaosDetails) {
+	probeTimeout := getProbeTimeouts(probe.Name, chaosresult.ProbeDetails)
+	var isExperimentFailed bool
+	// waiting for initial delay
+	if probeTimeout.InitialDelay != 0 {
+		log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+		time.Sleep(probeTimeout.InitialDelay)
+	}
+
+	// it triggers the grpc probe for the entire duration of chaos and it fails, if any error encounter
+	// it marked the error for the probes, if any
+loop:
+	for {
+		select {
+		case <-chaosDetails.ProbeContext.Ctx.Done():
+			log.Infof("Stopping %s continuous Probe", probe.Name)
+			for index := range chaosresult.ProbeDetails {
+				if chaosresult.ProbeDetails[index].Name == probe.Name {
+					chaosresult.ProbeDetails[index].HasProbeCompleted = true
+				}
+			}
+			break loop
+		default:
+			err = triggerGRPCProbe(probe, chaosresult)
+			// record the error inside the probeDetails, we are maintaining a dedicated variable for the err, inside probeDetails
+			if err != nil {
+				err = addProbePhase(err, string(chaosDetails.Phase))
+				for index := range chaosresult.ProbeDetails {
+					if chaosresult.ProbeDetails[index].Name == probe.Name {
+						chaosresult.ProbeDetails[index].IsProbeFailedWithError = err
+						chaosresult.ProbeDetails[index].HasProbeCompleted = true
+						chaosresult.ProbeDetails[index].Status.Description = getDescription(err)
+						log.Errorf("The %v grpc probe has been Failed, err: %v", probe.Name, err)
+						isExperimentFailed = true
+						break loop
+					}
+				}
+			}
+			// waiting for the probe polling interval
+			time.Sleep(probeTimeout.ProbePollingInterval)
+		}
+	}
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
+	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
+	// and failed the experiment in the end
+	if isExperimentFailed && probe.RunProperties.StopOnFailure {
+		if err := stopChaosEngine(probe, clients, chaosresult, chaosDetails); err != nil {
+			log.Errorf("Unable to patch chaosengine to stop, err: %v", err)
+		}
+	}
+}
+
+// preChaosGRPCProbe trigger the grpc probe for prechaos phase
+func preChaosGRPCProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	switch probe.Mode {
+	case "SOT", "Edge":
+
+		//DISPLAY THE GRPC PROBE INFO
+		log.InfoWithValues("[Probe]: The grpc probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PreChaos",
+		})
+
+		// waiting for initial delay
+		if probeTimeout.InitialDelay != 0 {
+			log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+			time.Sleep(probeTimeout.InitialDelay)
+		}
+		// trigger the grpc probe
+		if err = triggerGRPCProbe(probe, resultDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeGRPCProbe {
+			return err
+		}
+
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		// it will update the status of all the unrun probes as well
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PreChaos"); err != nil {
+			return err
+		}
+	case "Continuous":
+
+		//DISPLAY THE GRPC PROBE INFO
+		log.InfoWithValues("[Probe]: The grpc probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PreChaos",
+		})
+
+		go triggerContinuousGRPCProbe(probe, clients, resultDetails, chaosDetails)
+	}
+	return nil
+}
+
+// postChaosGRPCProbe trigger the grpc probe for postchaos phase
+func postChaosGRPCProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, delay int, timeout int) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	switch probe.Mode {
+	case "EOT", "Edge":
+
+		//DISPLAY THE GRPC PROBE INFO
+		log.InfoWithValues("[Probe]: The grpc probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PostChaos",
+		})
+
+		// waiting for initial delay
+		if probeTimeout.InitialDelay != 0 {
+			log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+			time.Sleep(probeTimeout.InitialDelay)
+		}
+
+		// trigger the grpc probe
+		if err = triggerGRPCProbe(probe, resultDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeGRPCProbe {
+			return err
+		}
+
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		// it will update the status of all the unrun probes as well
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PostChaos"); err != nil {
+			return err
+		}
+	case "Continuous", "OnChaos":
+		// it will check for the error, It will detect the error if any error encountered in probe during chaos
+		if err = checkForErrorInContinuousProbe(resultDetails, probe.Name, delay, timeout); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeGRPCProbe && cerrors.GetErrorType(err) != cerrors.FailureTypeProbeTimeout {
+			return err
+		}
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PostChaos"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// triggerOnChaosGRPCProbe trigger the onchaos grpc probes
+func triggerOnChaosGRPCProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosresult *types.ResultDetails, chaosDetails *types.ChaosDetails) {
+	probeTimeout := getProbeTimeouts(probe.Name, chaosresult.ProbeDetails)
+
+	var isExperimentFailed bool
+	duration := chaosDetails.ChaosDuration
+	// waiting for initial delay
+	if probeTimeout.InitialDelay != 0 {
+		log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+		time.Sleep(probeTimeout.InitialDelay)
+		duration = math.Maximum(0, duration-int(probeTimeout.InitialDelay.Seconds()))
+	}
+
+	endTime := time.After(time.Duration(duration) * time.Second)
+
+	// it trigger the grpc probe for the entire duration of chaos and it fails, if any error encounter
+	// it marked the error for the probes, if any
+loop:
+	for {
+		select {
+		case <-endTime:
+			log.Infof("[Chaos]: Time is up for the %v probe", probe.Name)
+			endTime = nil
+			for index := range chaosresult.ProbeDetails {
+				if chaosresult.ProbeDetails[index].Name == probe.Name {
+					chaosresult.ProbeDetails[index].HasProbeCompleted = true
+				}
+			}
+			break loop
+		default:
+			err = triggerGRPCProbe(probe, chaosresult)
+			// record the error inside the probeDetails, we are maintaining a dedicated variable for the err, inside probeDetails
+			if err != nil {
+				err = addProbePhase(err, string(chaosDetails.Phase))
+				for index := range chaosresult.ProbeDetails {
+					if chaosresult.ProbeDetails[index].Name == probe.Name {
+						chaosresult.ProbeDetails[index].IsProbeFailedWithError = err
+						chaosresult.ProbeDetails[index].HasProbeCompleted = true
+						chaosresult.ProbeDetails[index].Status.Description = getDescription(err)
+						isExperimentFailed = true
+						break loop
+					}
+				}
+			}
+
+			select {
+			case <-chaosDetails.ProbeContext.Ctx.Done():
+				log.Infof("Stopping %s continuous Probe", probe.Name)
+				for index := range chaosresult.ProbeDetails {
+					if chaosresult.ProbeDetails[index].Name == probe.Name {
+						chaosresult.ProbeDetails[index].HasProbeCompleted = true
+					}
+				}
+				break loop
+			default:
+				// waiting for the probe polling interval
+				time.Sleep(probeTimeout.ProbePollingInterval)
+			}
+		}
+	}
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
+	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
+	// and failed the experiment in the end
+	if isExperimentFailed && probe.RunProperties.StopOnFailure {
+		if err := stopChaosEngine(probe, clients, chaosresult, chaosDetails); err != nil {
+			log.Errorf("unable to patch chaosengine to stop, err: %v", err)
+		}
+	}
+}
+
+// onChaosGRPCProbe trigger the grpc probe for DuringChaos phase
+func onChaosGRPCProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) {
+
+	switch probe.Mode {
+	case "OnChaos":
+
+		//DISPLAY THE GRPC PROBE INFO
+		log.InfoWithValues("[Probe]: The grpc probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "DuringChaos",
+		})
+		go triggerOnChaosGRPCProbe(probe, clients, resultDetails, chaosDetails)
+	}
+}
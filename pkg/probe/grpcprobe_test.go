@@ -0,0 +1,94 @@
+package probe
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+func TestTriggerGRPCProbe(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("orders", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("payments", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	reflection.Register(server)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	tests := []struct {
+		name    string
+		data    string
+		failure bool
+	}{
+		{name: "serving", data: "address: " + lis.Addr().String() + "\nservice: orders"},
+		{name: "not serving", data: "address: " + lis.Addr().String() + "\nservice: payments", failure: true},
+		{name: "expected code", data: "address: " + lis.Addr().String() + "\nservice: unknown\ncode: NOT_FOUND"},
+		{name: "unexpected code", data: "address: " + lis.Addr().String() + "\nservice: unknown", failure: true},
+		{name: "response field", data: "address: " + lis.Addr().String() + `
+method: grpc.health.v1.Health/Check
+request: '{"service": "payments"}'
+comparator:
+  field: status
+  type: string
+  criteria: oneOf
+  value: SERVING,NOT_SERVING`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe, resultDetails := newTestProbe("check-orders", "grpcProbe", "SOT", tt.data, types.ProbeTimeouts{ProbeTimeout: 5 * time.Second})
+			assertProbeError(t, triggerGRPCProbe(probe, resultDetails), tt.failure, cerrors.FailureTypeGRPCProbe)
+		})
+	}
+}
+
+func TestReflectionFiles(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	reflection.Register(server)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := dialGRPC(&grpcProbeInputs{Address: lis.Addr().String()})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	files, err := reflectionFiles(context.Background(), conn, "grpc.health.v1.Health")
+	require.NoError(t, err)
+	_, err = files.FindDescriptorByName("grpc.health.v1.Health.Watch")
+	assert.NoError(t, err)
+}
+
+func TestParseGRPCProbeInputs(t *testing.T) {
+	inputs, err := parseGRPCProbeInputs(v1alpha1.ProbeAttributes{Data: "address: orders:50051"})
+	require.NoError(t, err)
+	assert.Equal(t, healthCheckMethod, inputs.Method)
+	assert.Equal(t, `{"service":""}`, inputs.Request)
+	assert.Equal(t, "SERVING", inputs.Comparator.Value)
+
+	for _, data := range []string{"", "service: orders", "address: orders:50051\ncode: BROKEN", "address: orders:50051\ntimeout: 5"} {
+		_, err := parseGRPCProbeInputs(v1alpha1.ProbeAttributes{Data: data})
+		assert.Error(t, err, data)
+	}
+
+	code, err := parseGRPCCode("not_found")
+	assert.NoError(t, err)
+	assert.Equal(t, "NotFound", code.String())
+}
@@ -2,17 +2,16 @@ package probe
 
 import (
 	"bytes"
+	"crypto/tls"
+	"encoding/json"
 	"fmt"
-	"github.com/litmuschaos/litmus-go/pkg/utils"
+	"io"
+	"net/http"
 	"os/exec"
-	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
-	"crypto/tls"
-	"net/http"
-
 	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
 	"github.com/litmuschaos/litmus-go/pkg/clients"
@@ -20,12 +19,19 @@ import (
 	"github.com/litmuschaos/litmus-go/pkg/math"
 	cmp "github.com/litmuschaos/litmus-go/pkg/probe/comparator"
 	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils"
+	"github.com/litmuschaos/litmus-go/pkg/utils/httpauth"
 	"github.com/litmuschaos/litmus-go/pkg/utils/retry"
 	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
 )
 
+// maxHTTPResponseBody is the maximum size of the response body, which is read for the comparison
+const maxHTTPResponseBody = 1 << 20
+
 // prepareHTTPProbe contains the steps to prepare the http probe
-// http probe can be used to add the probe which will send a request to given url and match the status code
+// http probe can be used to add the probe which will send a request to given url and match the status code, the response and the latency
 func prepareHTTPProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails, phase string) error {
 
 	switch strings.ToLower(phase) {
@@ -45,6 +51,101 @@ func prepareHTTPProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets
 	return nil
 }
 
+// httpProbeInputs contains the additional inputs of the http probe
+type httpProbeInputs struct {
+	// Method overrides the method of the request with PUT, PATCH or DELETE
+	// the body and the expected response code are still taken from the post or the get method
+	Method string `json:"method,omitempty"`
+	// Headers are sent along with the request
+	Headers map[string]string `json:"headers,omitempty"`
+	// Auth contains the bearer token or the basic auth credentials
+	httpauth.Auth `json:",inline"`
+	// TLS contains the ca cert and the client cert for the mTLS
+	TLS *httpauth.TLS `json:"tls,omitempty"`
+	// ResponseHeaders compares the values of the response headers, the multiple values of a header are separated by the commas
+	ResponseHeaders []httpProbeHeader `json:"responseHeaders,omitempty"`
+	// ResponseBody compares the response body, or the result of the JSONPath over the JSON response body
+	ResponseBody *httpProbeBody `json:"responseBody,omitempty"`
+	// Latency compares the latency of the request in milliseconds with the float criteria, such as <= 500
+	Latency *v1alpha1.ComparatorInfo `json:"latency,omitempty"`
+}
+
+// httpProbeHeader compares the value of the response header, the missing header is compared as empty
+type httpProbeHeader struct {
+	Name                    string `json:"name"`
+	v1alpha1.ComparatorInfo `json:",inline"`
+}
+
+// httpProbeBody compares the response body, the comparator type defaults to string
+type httpProbeBody struct {
+	// JSONPath is evaluated over the JSON response body, such as .status or {.checks[?(@.name=="db")].state}
+	JSONPath                string `json:"jsonPath,omitempty"`
+	v1alpha1.ComparatorInfo `json:",inline"`
+
+	jsonPath *jsonpath.JSONPath
+}
+
+// httpRequestSpec contains the method, the body and the expected response code of the request
+type httpRequestSpec struct {
+	method       string
+	body         string
+	contentType  string
+	criteria     string
+	responseCode string
+}
+
+// parseHTTPProbeInputs parses the additional inputs of the http probe from the data field
+func parseHTTPProbeInputs(probe v1alpha1.ProbeAttributes) (*httpProbeInputs, error) {
+	inputs := &httpProbeInputs{}
+	if err := yaml.UnmarshalStrict([]byte(probe.Data), inputs); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("failed to parse the http probe inputs: %s", err.Error())}
+	}
+	if err := inputs.Auth.Validate(); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+	}
+
+	inputs.Method = strings.ToUpper(inputs.Method)
+	switch inputs.Method {
+	case "", http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("method '%s' not supported in the http probe", inputs.Method)}
+	}
+
+	comparators := []*v1alpha1.ComparatorInfo{}
+	for i := range inputs.ResponseHeaders {
+		if inputs.ResponseHeaders[i].Name == "" {
+			return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: "name is required in the response headers"}
+		}
+		comparators = append(comparators, &inputs.ResponseHeaders[i].ComparatorInfo)
+	}
+	if inputs.ResponseBody != nil {
+		comparators = append(comparators, &inputs.ResponseBody.ComparatorInfo)
+		if path := strings.TrimSpace(inputs.ResponseBody.JSONPath); path != "" {
+			if !strings.HasPrefix(path, "{") {
+				path = "{" + path + "}"
+			}
+			inputs.ResponseBody.jsonPath = jsonpath.New(probe.Name).AllowMissingKeys(true)
+			if err := inputs.ResponseBody.jsonPath.Parse(path); err != nil {
+				return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("invalid jsonPath %q: %v", inputs.ResponseBody.JSONPath, err)}
+			}
+		}
+	}
+	for _, comparator := range comparators {
+		comparator.Type = strings.ToLower(comparator.Type)
+		switch comparator.Type {
+		case "":
+			comparator.Type = "string"
+		case "int", "float", "string":
+		default:
+			return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("comparator type '%s' not supported in the http probe", comparator.Type)}
+		}
+	}
+	if inputs.Latency != nil {
+		inputs.Latency.Type = "float"
+	}
+	return inputs, nil
+}
+
 // triggerHTTPProbe run the http probe command
 func triggerHTTPProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails) error {
 	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
@@ -56,54 +157,64 @@ func triggerHTTPProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.Resul
 		return err
 	}
 
-	// it fetches the http method type
-	method := getHTTPMethodType(probe.HTTPProbeInputs.Method)
+	inputs, err := parseHTTPProbeInputs(probe)
+	if err != nil {
+		return err
+	}
+	spec, err := getHTTPRequestSpec(probe, inputs)
+	if err != nil {
+		return err
+	}
 
 	// initialize simple http client with default attributes
 	client := &http.Client{Timeout: probeTimeout.ProbeTimeout}
-	// impose properties to http client with cert check disabled
-	if probe.HTTPProbeInputs.InsecureSkipVerify {
-		transCfg := &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	// impose properties to http client with the client cert or with cert check disabled
+	if inputs.TLS != nil || probe.HTTPProbeInputs.InsecureSkipVerify {
+		tlsConfig := &tls.Config{}
+		if inputs.TLS != nil {
+			if tlsConfig, err = inputs.TLS.Config(); err != nil {
+				return cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+			}
 		}
-		client = &http.Client{Transport: transCfg, Timeout: probeTimeout.ProbeTimeout}
+		tlsConfig.InsecureSkipVerify = tlsConfig.InsecureSkipVerify || probe.HTTPProbeInputs.InsecureSkipVerify
+		client.Transport = &http.Transport{TLSClientConfig: tlsConfig}
 	}
 
-	switch method {
-	case "Get":
-		log.InfoWithValues("[Probe]: HTTP get method informations", logrus.Fields{
-			"Name":            probe.Name,
-			"URL":             probe.HTTPProbeInputs.URL,
-			"Criteria":        probe.HTTPProbeInputs.Method.Get.Criteria,
-			"ResponseCode":    probe.HTTPProbeInputs.Method.Get.ResponseCode,
-			"ResponseTimeout": probe.RunProperties.ProbeTimeout,
-		})
-		return httpGet(probe, client, resultDetails)
-	case "Post":
-		log.InfoWithValues("[Probe]: HTTP Post method informations", logrus.Fields{
-			"Name":            probe.Name,
-			"URL":             probe.HTTPProbeInputs.URL,
-			"Body":            probe.HTTPProbeInputs.Method.Post.Body,
-			"BodyPath":        probe.HTTPProbeInputs.Method.Post.BodyPath,
-			"ContentType":     probe.HTTPProbeInputs.Method.Post.ContentType,
-			"ResponseTimeout": probe.RunProperties.ProbeTimeout,
-		})
-		return httpPost(probe, client, resultDetails)
-	}
-	return nil
+	log.InfoWithValues(fmt.Sprintf("[Probe]: HTTP %s method informations", strings.ToLower(spec.method)), logrus.Fields{
+		"Name":            probe.Name,
+		"URL":             probe.HTTPProbeInputs.URL,
+		"Criteria":        spec.criteria,
+		"ResponseCode":    spec.responseCode,
+		"ContentType":     spec.contentType,
+		"ResponseTimeout": probe.RunProperties.ProbeTimeout,
+	})
+	return httpRequest(probe, client, inputs, spec, resultDetails)
 }
 
-// it fetches the http method type
-// it supports Get and Post methods
-func getHTTPMethodType(httpMethod v1alpha1.HTTPMethod) string {
-	if !reflect.DeepEqual(httpMethod.Get, v1alpha1.GetMethod{}) {
-		return "Get"
+// getHTTPRequestSpec returns the method, the body and the expected response code of the request
+// the get method sends the request without body, the post method sends the body or the content of the bodyPath
+func getHTTPRequestSpec(probe v1alpha1.ProbeAttributes, inputs *httpProbeInputs) (httpRequestSpec, error) {
+	var spec httpRequestSpec
+	switch {
+	case probe.HTTPProbeInputs.Method.Get != nil:
+		spec = httpRequestSpec{method: http.MethodGet, criteria: probe.HTTPProbeInputs.Method.Get.Criteria, responseCode: probe.HTTPProbeInputs.Method.Get.ResponseCode}
+	case probe.HTTPProbeInputs.Method.Post != nil:
+		body, err := getHTTPBody(probe.HTTPProbeInputs.Method.Post, probe.Name)
+		if err != nil {
+			return spec, err
+		}
+		spec = httpRequestSpec{method: http.MethodPost, body: body, contentType: probe.HTTPProbeInputs.Method.Post.ContentType, criteria: probe.HTTPProbeInputs.Method.Post.Criteria, responseCode: probe.HTTPProbeInputs.Method.Post.ResponseCode}
+	default:
+		return spec, cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: "[Probe]: Any one of get or post method is required"}
 	}
-	return "Post"
+	if inputs.Method != "" {
+		spec.method = inputs.Method
+	}
+	return spec, nil
 }
 
-// httpGet send the http Get request to the given URL and verify the response code to follow the specified criteria
-func httpGet(probe v1alpha1.ProbeAttributes, client *http.Client, resultDetails *types.ResultDetails) error {
+// httpRequest send the http request to the given URL and verify the response code, the response headers, the response body and the latency to follow the specified criteria
+func httpRequest(probe v1alpha1.ProbeAttributes, client *http.Client, inputs *httpProbeInputs, spec httpRequestSpec, resultDetails *types.ResultDetails) error {
 	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
 	var description string
 
@@ -113,8 +224,24 @@ func httpGet(probe v1alpha1.ProbeAttributes, client *http.Client, resultDetails
 	if err := retry.Times(uint(getAttempts(probe.RunProperties.Attempt, probe.RunProperties.Retry))).
 		Wait(probeTimeout.Interval).
 		Try(func(attempt uint) error {
+			req, err := http.NewRequest(spec.method, probe.HTTPProbeInputs.URL, strings.NewReader(spec.body))
+			if err != nil {
+				return cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+			}
+			if spec.contentType != "" {
+				req.Header.Set("Content-Type", spec.contentType)
+			}
+			for name, value := range inputs.Headers {
+				req.Header.Set(name, value)
+			}
+			if err := inputs.Auth.Set(req); err != nil {
+				return cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+			}
+
 			// getting the response from the given url
-			resp, err := client.Get(probe.HTTPProbeInputs.URL)
+			start := time.Now()
+			resp, err := client.Do(req)
+			latency := time.Since(start)
 			if err != nil {
 				// Treat connection errors (timeout, connection refused, network unreachable, etc.) as failures
 				// instead of errors so they can be handled with stopOnFailure config
@@ -123,6 +250,7 @@ func httpGet(probe v1alpha1.ProbeAttributes, client *http.Client, resultDetails
 				}
 				return cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
 			}
+			defer resp.Body.Close()
 
 			code := strconv.Itoa(resp.StatusCode)
 			rc := getAndIncrementRunCount(resultDetails, probe.Name)
@@ -130,15 +258,24 @@ func httpGet(probe v1alpha1.ProbeAttributes, client *http.Client, resultDetails
 			// comparing the response code with the expected criteria
 			if err = cmp.RunCount(rc).
 				FirstValue(code).
-				SecondValue(probe.HTTPProbeInputs.Method.Get.ResponseCode).
-				Criteria(probe.HTTPProbeInputs.Method.Get.Criteria).
+				SecondValue(spec.responseCode).
+				Criteria(spec.criteria).
 				ProbeName(probe.Name).
 				ProbeVerbosity(probe.RunProperties.Verbosity).
 				CompareInt(cerrors.FailureTypeHttpProbe); err != nil {
-				log.Errorf("The %v http probe get method has Failed, err: %v", probe.Name, err)
+				log.Errorf("The %v http probe %s method has Failed, err: %v", probe.Name, strings.ToLower(spec.method), err)
 				return err
 			}
-			description = fmt.Sprintf("The URL %s did respond with correct status code. Actual code: '%s'. Expected code: '%s'", probe.HTTPProbeInputs.URL, code, probe.HTTPProbeInputs.Method.Get.ResponseCode)
+			description = fmt.Sprintf("The URL %s did respond with correct status code. Actual code: '%s'. Expected code: '%s'", probe.HTTPProbeInputs.URL, code, spec.responseCode)
+
+			assertions, err := validateHTTPResponse(probe, inputs, resp, latency, rc)
+			if err != nil {
+				log.Errorf("The %v http probe %s method has Failed, err: %v", probe.Name, strings.ToLower(spec.method), err)
+				return err
+			}
+			if assertions != "" {
+				description = fmt.Sprintf("%s. %s", description, assertions)
+			}
 			return nil
 		}); err != nil {
 		return err
@@ -147,52 +284,53 @@ func httpGet(probe v1alpha1.ProbeAttributes, client *http.Client, resultDetails
 	return nil
 }
 
-// httpPost send the http post request to the given URL
-func httpPost(probe v1alpha1.ProbeAttributes, client *http.Client, resultDetails *types.ResultDetails) error {
-	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
-	body, err := getHTTPBody(probe.HTTPProbeInputs.Method.Post, probe.Name)
-	if err != nil {
-		return err
+// validateHTTPResponse verify the response headers, the response body and the latency to follow the specified criteria
+// it returns the description of the compared values
+func validateHTTPResponse(probe v1alpha1.ProbeAttributes, inputs *httpProbeInputs, resp *http.Response, latency time.Duration, rc int) (string, error) {
+	var descriptions []string
+	compare := func(subject, value string, comparator v1alpha1.ComparatorInfo) error {
+		if _, err := validateResult(comparator, probe.Name, probe.RunProperties.Verbosity, value, rc); err != nil {
+			return cerrors.Error{ErrorCode: cerrors.FailureTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("%s: %s", subject, getDescription(err))}
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%s: '%s'", subject, value))
+		return nil
 	}
 
-	var description string
+	for _, header := range inputs.ResponseHeaders {
+		if err := compare(fmt.Sprintf("%s header", header.Name), strings.Join(resp.Header.Values(header.Name), ", "), header.ComparatorInfo); err != nil {
+			return "", err
+		}
+	}
 
-	// it will retry for some retry count, in each iteration of try it contains following things
-	// it contains a timeout per iteration of retry. if the timeout expires without success then it will go to next try
-	// for a timeout, it will run the command, if it fails wait for the interval and again execute the command until timeout expires
-	if err := retry.Times(uint(getAttempts(probe.RunProperties.Attempt, probe.RunProperties.Retry))).
-		Wait(probeTimeout.Interval).
-		Try(func(attempt uint) error {
-			resp, err := client.Post(probe.HTTPProbeInputs.URL, probe.HTTPProbeInputs.Method.Post.ContentType, strings.NewReader(body))
-			if err != nil {
-				// Treat connection errors (timeout, connection refused, network unreachable, etc.) as failures
-				// instead of errors so they can be handled with stopOnFailure config
-				if utils.HttpTimeout(err) || utils.IsConnectionError(err) {
-					return cerrors.Error{ErrorCode: cerrors.FailureTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
-				}
-				return cerrors.Error{ErrorCode: cerrors.ErrorTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+	if inputs.ResponseBody != nil {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseBody))
+		if err != nil {
+			return "", cerrors.Error{ErrorCode: cerrors.FailureTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to read the response body, err: %v", err)}
+		}
+		value, subject := strings.TrimSpace(string(body)), "response body"
+		if inputs.ResponseBody.jsonPath != nil {
+			var obj interface{}
+			if err := json.Unmarshal(body, &obj); err != nil {
+				return "", cerrors.Error{ErrorCode: cerrors.FailureTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("the response body is not a valid JSON, err: %v", err)}
 			}
-			code := strconv.Itoa(resp.StatusCode)
-			rc := getAndIncrementRunCount(resultDetails, probe.Name)
-
-			// comparing the response code with the expected criteria
-			if err = cmp.RunCount(rc).
-				FirstValue(code).
-				SecondValue(probe.HTTPProbeInputs.Method.Post.ResponseCode).
-				Criteria(probe.HTTPProbeInputs.Method.Post.Criteria).
-				ProbeName(probe.Name).
-				ProbeVerbosity(probe.RunProperties.Verbosity).
-				CompareInt(cerrors.FailureTypeHttpProbe); err != nil {
-				log.Errorf("The %v http probe post method has Failed, err: %v", probe.Name, err)
-				return err
+			var out bytes.Buffer
+			if err := inputs.ResponseBody.jsonPath.Execute(&out, obj); err != nil {
+				return "", cerrors.Error{ErrorCode: cerrors.FailureTypeHttpProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to evaluate the jsonPath over the response body, err: %v", err)}
 			}
-			description = fmt.Sprintf("The URL %s did respond with correct status code. Actual code: '%s'. Expected code: '%s'", probe.HTTPProbeInputs.URL, code, probe.HTTPProbeInputs.Method.Get.ResponseCode)
-			return nil
-		}); err != nil {
-		return err
+			value, subject = strings.TrimSpace(out.String()), fmt.Sprintf("%s of the response body", inputs.ResponseBody.JSONPath)
+		}
+		if err := compare(subject, value, inputs.ResponseBody.ComparatorInfo); err != nil {
+			return "", err
+		}
 	}
-	setProbeDescription(resultDetails, probe, description)
-	return nil
+
+	if inputs.Latency != nil {
+		ms := strconv.FormatFloat(float64(latency.Microseconds())/1000, 'f', 3, 64)
+		if err := compare("latency (ms)", ms, *inputs.Latency); err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(descriptions, ". "), nil
 }
 
 // getHTTPBody fetch the http body for the post request
@@ -267,7 +405,7 @@ loop:
 			time.Sleep(probeTimeout.ProbePollingInterval)
 		}
 	}
-	// if experiment fails and stopOnfailure is provided as true then it will patch the chaosengine for abort
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
 	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
 	// and failed the experiment in the end
 	if isExperimentFailed && probe.RunProperties.StopOnFailure {
@@ -430,7 +568,7 @@ loop:
 			}
 		}
 	}
-	// if experiment fails and stopOnfailure is provided as true then it will patch the chaosengine for abort
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
 	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
 	// and failed the experiment in the end
 	if isExperimentFailed && probe.RunProperties.StopOnFailure {
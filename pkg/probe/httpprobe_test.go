@@ -0,0 +1,85 @@
+package probe
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+func TestTriggerHTTPProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, _ := r.BasicAuth()
+		switch {
+		case r.URL.Path == "/health":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"degraded","checks":[{"name":"db","state":"up"},{"name":"cache","state":"down"}]}`))
+		case r.URL.Path == "/orders/1" && r.Method == http.MethodPatch && user == "admin" && password == "secret":
+			body, _ := io.ReadAll(r.Body)
+			w.Write(body)
+		case r.URL.Path == "/orders/1" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	get := v1alpha1.HTTPMethod{Get: &v1alpha1.GetMethod{Criteria: "==", ResponseCode: "200"}}
+	tests := []struct {
+		name    string
+		path    string
+		method  v1alpha1.HTTPMethod
+		data    string
+		failure bool
+	}{
+		{name: "status code", path: "/health", method: get},
+		{name: "degraded status", path: "/health", method: get, data: "responseBody:\n  jsonPath: .status\n  criteria: equal\n  value: ok", failure: true},
+		{name: "json path filter", path: "/health", method: get, data: "responseBody:\n  jsonPath: '{.checks[?(@.name==\"db\")].state}'\n  criteria: equal\n  value: up"},
+		{name: "body regex", path: "/health", method: get, data: "responseBody:\n  criteria: matches\n  value: '\"cache\",\"state\":\"(up|down)\"'"},
+		{name: "response header", path: "/health", method: get, data: "responseHeaders:\n- name: content-type\n  criteria: contains\n  value: json"},
+		{name: "latency", path: "/health", method: get, data: "latency:\n  criteria: <=\n  value: \"5000\""},
+		{name: "patch with basic auth", path: "/orders/1",
+			method: v1alpha1.HTTPMethod{Post: &v1alpha1.PostMethod{Body: `{"state":"shipped"}`, ContentType: "application/json", Criteria: "==", ResponseCode: "200"}},
+			data:   "method: patch\nbasicAuth:\n  username: admin\n  password: secret\nresponseBody:\n  jsonPath: .state\n  criteria: equal\n  value: shipped"},
+		{name: "patch without auth", path: "/orders/1",
+			method: v1alpha1.HTTPMethod{Post: &v1alpha1.PostMethod{Body: `{}`, Criteria: "==", ResponseCode: "200"}}, data: "method: PATCH", failure: true},
+		{name: "delete", path: "/orders/1", method: v1alpha1.HTTPMethod{Get: &v1alpha1.GetMethod{Criteria: "==", ResponseCode: "204"}}, data: "method: DELETE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe, resultDetails := newTestProbe("check-orders", "httpProbe", "SOT", tt.data, types.ProbeTimeouts{ProbeTimeout: 5 * time.Second})
+			probe.HTTPProbeInputs = &v1alpha1.HTTPProbeInputs{URL: server.URL + tt.path, Method: tt.method}
+			assertProbeError(t, triggerHTTPProbe(probe, resultDetails), tt.failure, cerrors.FailureTypeHttpProbe)
+		})
+	}
+}
+
+func TestParseHTTPProbeInputs(t *testing.T) {
+	inputs, err := parseHTTPProbeInputs(v1alpha1.ProbeAttributes{Data: "method: put\nresponseBody:\n  value: ok\nlatency:\n  criteria: <\n  value: \"100\""})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, inputs.Method)
+	assert.Equal(t, "string", inputs.ResponseBody.Type)
+	assert.Equal(t, "float", inputs.Latency.Type)
+
+	for _, data := range []string{
+		"method: TRACE",
+		"responseHeaders:\n- criteria: equal\n  value: ok",
+		"responseBody:\n  type: bool",
+		"responseBody:\n  jsonPath: '{.status'",
+		"bearerToken: a\nbasicAuth:\n  username: b",
+		"timeout: 5",
+	} {
+		_, err := parseHTTPProbeInputs(v1alpha1.ProbeAttributes{Data: data})
+		assert.Error(t, err, data)
+	}
+}
@@ -1,6 +1,7 @@
 package probe
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -20,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+	"k8s.io/client-go/util/jsonpath"
+	k8syaml "sigs.k8s.io/yaml"
 )
 
 // prepareK8sProbe contains the steps to prepare the k8s probe
@@ -110,6 +113,12 @@ func triggerK8sProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets,
 					log.Errorf("the %v k8s probe has Failed, err: %v", probe.Name, err)
 					return err
 				}
+			case "check":
+				if description, err = checkResources(probe, gvr, parsedResourceNames, clients, resultDetails); err != nil {
+					log.Errorf("the %v k8s probe has Failed, err: %v", probe.Name, err)
+					return err
+				}
+				return nil
 			default:
 				return cerrors.Error{ErrorCode: cerrors.ErrorTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("operation type '%s' not supported in the k8s probe", inputs.Operation)}
 			}
@@ -168,7 +177,7 @@ loop:
 			time.Sleep(probeTimeout.ProbePollingInterval)
 		}
 	}
-	// if experiment fails and stopOnfailure is provided as true then it will patch the chaosengine for abort
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
 	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
 	// and failed the experiment in the end
 	if isExperimentFailed && probe.RunProperties.StopOnFailure {
@@ -293,6 +302,106 @@ func resourcesAbsent(probe v1alpha1.ProbeAttributes, gvr schema.GroupVersionReso
 	return nil
 }
 
+// k8sProbeCheck contains the inputs of the check operation of the k8s probe
+// they are provided as YAML inside the data field of the probe, as the manifest of the create operation
+type k8sProbeCheck struct {
+	// JSONPath is evaluated over each matched resource, such as .status.readyReplicas or {.status.conditions[?(@.type=="Available")].status}
+	// the missing fields are evaluated as empty, and the multiple results are separated by the spaces
+	JSONPath string `json:"jsonPath"`
+	// Comparator compares the result of the JSONPath, the int and float comparisons treat the empty result as zero
+	Comparator v1alpha1.ComparatorInfo `json:"comparator"`
+	// Match is either all or any, i.e, whether all the matched resources or at least one of them should follow the criteria
+	Match string `json:"match,omitempty"`
+}
+
+// checkResources evaluates the JSONPath over the resources with matching names or selectors, and compares the results with the criteria
+func checkResources(probe v1alpha1.ProbeAttributes, gvr schema.GroupVersionResource, parsedResourceNames []string, clients clients.ClientSets, resultDetails *types.ResultDetails) (string, error) {
+	check := k8sProbeCheck{}
+	if err := k8syaml.UnmarshalStrict([]byte(probe.Data), &check); err != nil {
+		return "", cerrors.Error{ErrorCode: cerrors.ErrorTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("failed to parse the check inputs: %v", err)}
+	}
+	check.Match = strings.ToLower(check.Match)
+	if check.Match == "" {
+		check.Match = "all"
+	}
+	if check.Match != "all" && check.Match != "any" {
+		return "", cerrors.Error{ErrorCode: cerrors.ErrorTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("match '%s' not supported in the k8s probe, it can be all or any", check.Match)}
+	}
+
+	switch strings.ToLower(check.Comparator.Type) {
+	case "int", "float", "string":
+	default:
+		return "", cerrors.Error{ErrorCode: cerrors.ErrorTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("comparator type '%s' not supported in the k8s probe", check.Comparator.Type)}
+	}
+
+	path := strings.TrimSpace(check.JSONPath)
+	if path == "" {
+		return "", cerrors.Error{ErrorCode: cerrors.ErrorTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: "jsonPath is required for the check operation"}
+	}
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+	jp := jsonpath.New(probe.Name).AllowMissingKeys(true)
+	if err := jp.Parse(path); err != nil {
+		return "", cerrors.Error{ErrorCode: cerrors.ErrorTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("invalid jsonPath %q: %v", check.JSONPath, err)}
+	}
+
+	var resources []unstructured.Unstructured
+	if len(parsedResourceNames) > 0 {
+		for _, res := range parsedResourceNames {
+			resource, err := clients.DynamicClient.Resource(gvr).Namespace(probe.K8sProbeInputs.Namespace).Get(context.Background(), res, v1.GetOptions{})
+			if err != nil {
+				if k8serrors.IsNotFound(err) {
+					return "", cerrors.Error{ErrorCode: cerrors.FailureTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("resource '%v' not found", res)}
+				}
+				return "", cerrors.Error{ErrorCode: cerrors.ErrorTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to get the resources with name %v, err: %v", res, err)}
+			}
+			resources = append(resources, *resource)
+		}
+	} else {
+		resourceList, err := clients.DynamicClient.Resource(gvr).Namespace(probe.K8sProbeInputs.Namespace).List(context.Background(), v1.ListOptions{
+			FieldSelector: probe.K8sProbeInputs.FieldSelector,
+			LabelSelector: probe.K8sProbeInputs.LabelSelector,
+		})
+		if err != nil {
+			return "", cerrors.Error{ErrorCode: cerrors.ErrorTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to list the resources with matching selector, err: %v", err)}
+		}
+		if len(resourceList.Items) == 0 {
+			return "", cerrors.Error{ErrorCode: cerrors.FailureTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("no resource found with provided {labelSelectors: %s, fieldSelectors: %s} selectors", probe.K8sProbeInputs.LabelSelector, probe.K8sProbeInputs.FieldSelector)}
+		}
+		resources = resourceList.Items
+	}
+
+	rc := getAndIncrementRunCount(resultDetails, probe.Name)
+	var values []string
+	var checkErr error
+	for _, resource := range resources {
+		var out bytes.Buffer
+		if err := jp.Execute(&out, resource.Object); err != nil {
+			return "", cerrors.Error{ErrorCode: cerrors.ErrorTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to evaluate the jsonPath over the %s resource, err: %v", resource.GetName(), err)}
+		}
+		value := strings.TrimSpace(out.String())
+		values = append(values, fmt.Sprintf("%s: '%s'", resource.GetName(), value))
+
+		// the comparator type is already verified, so the errors are the failures to follow the criteria
+		if _, err := validateResult(check.Comparator, probe.Name, probe.RunProperties.Verbosity, value, rc); err != nil {
+			err = cerrors.Error{ErrorCode: cerrors.FailureTypeK8sProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("resource %s: %s", resource.GetName(), getDescription(err))}
+			if check.Match == "all" {
+				return "", err
+			}
+			checkErr = err
+			continue
+		}
+		if check.Match == "any" {
+			return fmt.Sprintf("The %s of the %s resource matched the criteria. Actual value: '%s'. Expected value: '%s'", check.JSONPath, resource.GetName(), value, check.Comparator.Value), nil
+		}
+	}
+	if check.Match == "any" {
+		return "", checkErr
+	}
+	return fmt.Sprintf("The %s of all the %d resources matched the criteria. Actual values: [%s]. Expected value: '%s'", check.JSONPath, len(resources), strings.Join(values, ", "), check.Comparator.Value), nil
+}
+
 // preChaosK8sProbe trigger the k8s probe for prechaos phase
 func preChaosK8sProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
 	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
@@ -460,7 +569,7 @@ loop:
 			}
 		}
 	}
-	// if experiment fails and stopOnfailure is provided as true then it will patch the chaosengine for abort
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
 	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
 	// and failed the experiment in the end
 	if isExperimentFailed && probe.RunProperties.StopOnFailure {
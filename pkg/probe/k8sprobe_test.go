@@ -0,0 +1,69 @@
+package probe
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+func deployment(name string, availableReplicas int64, available string) *unstructured.Unstructured {
+	d := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default", "labels": map[string]interface{}{"app": "orders"}},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Progressing", "status": "True"},
+				map[string]interface{}{"type": "Available", "status": available},
+			},
+		},
+	}}
+	if availableReplicas != 0 {
+		unstructured.SetNestedField(d.Object, availableReplicas, "status", "availableReplicas")
+	}
+	return d
+}
+
+func TestCheckResources(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{gvr: "DeploymentList"},
+		deployment("orders-v1", 3, "True"), deployment("orders-v2", 0, "False"))
+
+	tests := []struct {
+		name    string
+		names   []string
+		data    string
+		failure bool
+	}{
+		{name: "all match", names: []string{"orders-v1"}, data: "jsonPath: .status.availableReplicas\ncomparator: {type: int, criteria: '>=', value: '2'}"},
+		{name: "missing field is zero", data: "jsonPath: .status.availableReplicas\ncomparator: {type: int, criteria: '>=', value: '2'}", failure: true},
+		{name: "any match", data: "jsonPath: .status.availableReplicas\nmatch: any\ncomparator: {type: int, criteria: '>=', value: '2'}"},
+		{name: "filter", data: "jsonPath: '{.status.conditions[?(@.type==\"Available\")].status}'\nmatch: any\ncomparator: {type: string, criteria: equal, value: 'False'}"},
+		{name: "filter mismatch", names: []string{"orders-v1"}, data: "jsonPath: '.status.conditions[?(@.type==\"Available\")].status'\ncomparator: {type: string, criteria: equal, value: 'False'}", failure: true},
+		{name: "missing resource", names: []string{"payments"}, data: "jsonPath: .status.availableReplicas\ncomparator: {type: int, criteria: '>=', value: '2'}", failure: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe, resultDetails := newTestProbe("check-orders", "k8sProbe", "", tt.data, types.ProbeTimeouts{})
+			probe.K8sProbeInputs = &v1alpha1.K8sProbeInputs{Namespace: "default", LabelSelector: "app=orders", Operation: "check"}
+			_, err := checkResources(probe, gvr, tt.names, clients.ClientSets{DynamicClient: dynamicClient}, resultDetails)
+			assertProbeError(t, err, tt.failure, cerrors.FailureTypeK8sProbe)
+		})
+	}
+
+	for _, data := range []string{"comparator: {type: int, criteria: '>=', value: '2'}", "jsonPath: .status\ncomparator: {type: bool}", "jsonPath: .status\nmatch: most\ncomparator: {type: int}", "jsonPath: '{.status'\ncomparator: {type: int}"} {
+		probe := v1alpha1.ProbeAttributes{Name: "check-orders", Data: data, K8sProbeInputs: &v1alpha1.K8sProbeInputs{Namespace: "default"}}
+		_, err := checkResources(probe, gvr, nil, clients.ClientSets{DynamicClient: dynamicClient}, &types.ResultDetails{})
+		assert.Equal(t, cerrors.ErrorTypeK8sProbe, cerrors.GetErrorType(err), data)
+	}
+}
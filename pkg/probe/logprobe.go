@@ -0,0 +1,589 @@
+package probe
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/math"
+	cmp "github.com/litmuschaos/litmus-go/pkg/probe/comparator"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/retry"
+	"github.com/litmuschaos/litmus-go/pkg/workloads"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// maxLogLineSize is the maximum size of a log line, the longer lines fail the scan of the logs
+const maxLogLineSize = 1 << 20
+
+// logProbeInputs contains the inputs of the log probe
+type logProbeInputs struct {
+	// Namespace of the pods, it defaults to the namespace of the target application
+	Namespace string `json:"namespace,omitempty"`
+	// Pods are the names of the pods, the pods are selected by the LabelSelector otherwise
+	Pods []string `json:"pods,omitempty"`
+	// LabelSelector selects the pods, the target pods of the experiment are used if neither of the pods and the labelSelector are provided
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// Container is the container of the pods, the logs of all the containers are checked otherwise
+	Container string `json:"container,omitempty"`
+	// Pattern is the regex, which is matched with each log line
+	Pattern string `json:"pattern"`
+	// Per counts the matching lines within every window of the given duration, such as 1m, and compares the highest count
+	// the total count of the matching lines is compared otherwise
+	Per string `json:"per,omitempty"`
+	// Window is the duration of the logs checked by the SOT, EOT and Edge modes, it defaults to the chaos duration
+	Window string `json:"window,omitempty"`
+	// Comparator compares the count of the matching lines with the int criteria, it defaults to >= 1, i.e, the logs should contain the pattern
+	Comparator *v1alpha1.ComparatorInfo `json:"comparator,omitempty"`
+
+	re     *regexp.Regexp
+	per    time.Duration
+	window time.Duration
+}
+
+// logTarget is a container, whose logs are checked by the log probe
+type logTarget struct {
+	namespace, pod, container string
+}
+
+func (t logTarget) String() string {
+	return fmt.Sprintf("%s/%s/%s", t.namespace, t.pod, t.container)
+}
+
+// logMatches contains the timestamps of the matching lines, which are shared by the logs of all the targets
+type logMatches struct {
+	sync.Mutex
+	times []time.Time
+}
+
+// prepareLogProbe contains the steps to prepare the log probe
+// log probe can be used to add the probe which will match the pattern with the logs of the pods and compare the count of the matching lines
+func prepareLogProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails, phase string) error {
+
+	switch strings.ToLower(phase) {
+	case "prechaos":
+		if err := preChaosLogProbe(probe, resultDetails, clients, chaosDetails); err != nil {
+			return err
+		}
+	case "postchaos":
+		if err := postChaosLogProbe(probe, resultDetails, clients, chaosDetails); err != nil {
+			return err
+		}
+	case "duringchaos":
+		onChaosLogProbe(probe, resultDetails, clients, chaosDetails)
+	default:
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("phase '%s' not supported in the log probe", phase)}
+	}
+	return nil
+}
+
+// parseLogProbeInputs parses the inputs of the log probe from the data field and fills in the defaults
+func parseLogProbeInputs(probe v1alpha1.ProbeAttributes, chaosDetails *types.ChaosDetails) (*logProbeInputs, error) {
+	inputs := &logProbeInputs{}
+	if err := yaml.UnmarshalStrict([]byte(probe.Data), inputs); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("failed to parse the log probe inputs: %s", err.Error())}
+	}
+	if inputs.Pattern == "" {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: "pattern is required in the log probe"}
+	}
+
+	var err error
+	if inputs.re, err = regexp.Compile(inputs.Pattern); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("invalid pattern %q: %v", inputs.Pattern, err)}
+	}
+	if inputs.Per != "" {
+		if inputs.per, err = time.ParseDuration(inputs.Per); err != nil || inputs.per <= 0 {
+			return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("invalid per duration %q", inputs.Per)}
+		}
+	}
+	inputs.window = time.Duration(chaosDetails.ChaosDuration) * time.Second
+	if inputs.Window != "" {
+		if inputs.window, err = time.ParseDuration(inputs.Window); err != nil || inputs.window <= 0 {
+			return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("invalid window duration %q", inputs.Window)}
+		}
+	}
+	if inputs.Comparator == nil {
+		inputs.Comparator = &v1alpha1.ComparatorInfo{Type: "int", Criteria: ">=", Value: "1"}
+	}
+
+	if inputs.Namespace == "" && len(chaosDetails.AppDetail) != 0 {
+		inputs.Namespace = chaosDetails.AppDetail[0].Namespace
+	}
+	if inputs.Namespace == "" {
+		inputs.Namespace = chaosDetails.ChaosNamespace
+	}
+	return inputs, nil
+}
+
+// triggerLogProbe matches the pattern with the logs of the window and verify the count of the matching lines to follow the specified criteria
+func triggerLogProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, resultDetails *types.ResultDetails, chaosDetails *types.ChaosDetails) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	inputs, err := parseLogProbeInputs(probe, chaosDetails)
+	if err != nil {
+		return err
+	}
+
+	log.InfoWithValues("[Probe]: Log probe informations", logrus.Fields{
+		"Name":       probe.Name,
+		"Pattern":    inputs.Pattern,
+		"Window":     inputs.window,
+		"Per":        inputs.Per,
+		"Comparator": inputs.Comparator,
+	})
+
+	var description string
+	// it will retry for some retry count, in each iteration of try it contains following things
+	// it contains a timeout per iteration of retry. if the timeout expires without success then it will go to next try
+	// for a timeout, it will read the logs, if it fails wait for the interval and again read the logs until timeout expires
+	if err := retry.Times(uint(getAttempts(probe.RunProperties.Attempt, probe.RunProperties.Retry))).
+		Wait(probeTimeout.Interval).
+		Try(func(attempt uint) error {
+			ctx := context.Background()
+			if probeTimeout.ProbeTimeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, probeTimeout.ProbeTimeout)
+				defer cancel()
+			}
+
+			targets, err := logProbeTargets(probe, inputs, clients, chaosDetails)
+			if err != nil {
+				return err
+			}
+
+			matches := &logMatches{}
+			var read int
+			sinceSeconds := int64(inputs.window.Seconds())
+			for _, target := range targets {
+				opts := &corev1.PodLogOptions{Container: target.container, Timestamps: true}
+				if sinceSeconds > 0 {
+					opts.SinceSeconds = &sinceSeconds
+				}
+				if _, err := readLogs(ctx, clients, target, opts, inputs.re, matches, time.Time{}); err != nil {
+					log.Warnf("Unable to read the logs of the %v container, err: %v", target, err)
+					continue
+				}
+				read++
+			}
+			if read == 0 {
+				return cerrors.Error{ErrorCode: cerrors.ErrorTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: "unable to read the logs of any of the containers"}
+			}
+
+			rc := getAndIncrementRunCount(resultDetails, probe.Name)
+			if description, err = compareLogMatches(probe, inputs, matches, rc); err != nil {
+				log.Errorf("The %v log probe has been Failed, err: %v", probe.Name, err)
+				return err
+			}
+			return nil
+		}); err != nil {
+		return err
+	}
+	setProbeDescription(resultDetails, probe, description)
+	return nil
+}
+
+// streamLogProbe follows the logs of the targets until the context is done, and verify the count of the matching lines to follow the specified criteria
+// the targets are resolved again on every polling interval, so that the logs of the pods recreated by the chaos are followed as well
+// the upper bound criteria, i.e, < and <=, fail as soon as they are violated, as the count of the matching lines never decreases
+func streamLogProbe(ctx context.Context, probe v1alpha1.ProbeAttributes, clients clients.ClientSets, resultDetails *types.ResultDetails, chaosDetails *types.ChaosDetails) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	inputs, err := parseLogProbeInputs(probe, chaosDetails)
+	if err != nil {
+		return err
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	interval := probeTimeout.ProbePollingInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	since := v1.Now()
+	matches := &logMatches{}
+	followed := map[string]bool{}
+	follow := func() error {
+		targets, err := logProbeTargets(probe, inputs, clients, chaosDetails)
+		if err != nil {
+			return err
+		}
+		for _, target := range targets {
+			if followed[target.String()] {
+				continue
+			}
+			followed[target.String()] = true
+			go followLogs(streamCtx, clients, target, since, interval, inputs.re, matches)
+		}
+		return nil
+	}
+	if err := follow(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			rc := getAndIncrementRunCount(resultDetails, probe.Name)
+			description, err := compareLogMatches(probe, inputs, matches, rc)
+			if err != nil {
+				return err
+			}
+			setProbeDescription(resultDetails, probe, description)
+			return nil
+		case <-ticker.C:
+			if err := follow(); err != nil {
+				log.Warnf("Unable to get the pods of the %v log probe, err: %v", probe.Name, err)
+			}
+			if inputs.Comparator.Criteria == "<" || inputs.Comparator.Criteria == "<=" {
+				rc := getAndIncrementRunCount(resultDetails, probe.Name)
+				if _, err := compareLogMatches(probe, inputs, matches, rc); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// followLogs follows the logs of the target until the context is done
+// the logs are followed again, if the stream ends, such as on the restart of the container, the lines which are already read are skipped
+func followLogs(ctx context.Context, clients clients.ClientSets, target logTarget, since v1.Time, interval time.Duration, re *regexp.Regexp, matches *logMatches) {
+	var last time.Time
+	for ctx.Err() == nil {
+		opts := &corev1.PodLogOptions{Container: target.container, Timestamps: true, Follow: true, SinceTime: &since}
+		latest, err := readLogs(ctx, clients, target, opts, re, matches, last)
+		if latest.After(last) {
+			last = latest
+		}
+		if err != nil && ctx.Err() == nil {
+			log.Warnf("Unable to follow the logs of the %v container, err: %v", target, err)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(interval):
+		}
+	}
+}
+
+// readLogs reads the logs of the target and records the matching lines, the lines till the given timestamp are skipped
+// it returns the timestamp of the last line
+func readLogs(ctx context.Context, clients clients.ClientSets, target logTarget, opts *corev1.PodLogOptions, re *regexp.Regexp, matches *logMatches, after time.Time) (time.Time, error) {
+	stream, err := clients.KubeClient.CoreV1().Pods(target.namespace).GetLogs(target.pod, opts).Stream(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer stream.Close()
+	return scanLogs(stream, re, matches, after)
+}
+
+// scanLogs records the timestamps of the lines matching the regex, the lines are prefixed with the timestamps by the kubelet
+// the lines till the given timestamp are skipped, and the lines without the timestamps are recorded at the current time
+func scanLogs(r io.Reader, re *regexp.Regexp, matches *logMatches, after time.Time) (time.Time, error) {
+	var last time.Time
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLogLineSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		timestamp := time.Now()
+		if i := strings.IndexByte(line, ' '); i != -1 {
+			if t, err := time.Parse(time.RFC3339Nano, line[:i]); err == nil {
+				if !t.After(after) {
+					continue
+				}
+				timestamp, last, line = t, t, line[i+1:]
+			}
+		}
+		if re.MatchString(line) {
+			matches.add(timestamp)
+		}
+	}
+	return last, scanner.Err()
+}
+
+// logProbeTargets returns the containers of the given pods, of the pods matching the label selector, or of the target pods
+func logProbeTargets(probe v1alpha1.ProbeAttributes, inputs *logProbeInputs, clients clients.ClientSets, chaosDetails *types.ChaosDetails) ([]logTarget, error) {
+	var pods []corev1.Pod
+	switch {
+	case len(inputs.Pods) != 0:
+		for _, name := range inputs.Pods {
+			pod, err := clients.KubeClient.CoreV1().Pods(inputs.Namespace).Get(context.Background(), name, v1.GetOptions{})
+			if err != nil {
+				return nil, cerrors.Error{ErrorCode: cerrors.FailureTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to get the %s pod, err: %v", name, err)}
+			}
+			pods = append(pods, *pod)
+		}
+	case inputs.LabelSelector != "":
+		podList, err := clients.KubeClient.CoreV1().Pods(inputs.Namespace).List(context.Background(), v1.ListOptions{LabelSelector: inputs.LabelSelector})
+		if err != nil {
+			return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to list the pods with %s labels, err: %v", inputs.LabelSelector, err)}
+		}
+		pods = podList.Items
+	default:
+		for _, target := range chaosDetails.AppDetail {
+			switch {
+			case target.Kind == "pod":
+				for _, name := range target.Names {
+					pod, err := clients.KubeClient.CoreV1().Pods(target.Namespace).Get(context.Background(), name, v1.GetOptions{})
+					if err != nil {
+						return nil, cerrors.Error{ErrorCode: cerrors.FailureTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to get the %s pod, err: %v", name, err)}
+					}
+					pods = append(pods, *pod)
+				}
+			case len(target.Names) != 0:
+				podList, err := workloads.GetPodsFromWorkloads(target, clients)
+				if err != nil {
+					return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to get the pods of the target workloads, err: %v", err)}
+				}
+				pods = append(pods, podList.Items...)
+			default:
+				for _, label := range target.Labels {
+					podList, err := clients.KubeClient.CoreV1().Pods(target.Namespace).List(context.Background(), v1.ListOptions{LabelSelector: label})
+					if err != nil {
+						return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to list the pods with %s labels, err: %v", label, err)}
+					}
+					pods = append(pods, podList.Items...)
+				}
+			}
+		}
+	}
+	if len(pods) == 0 {
+		return nil, cerrors.Error{ErrorCode: cerrors.FailureTypeLogProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: "no pods found to check the logs"}
+	}
+
+	var targets []logTarget
+	for _, pod := range pods {
+		if inputs.Container != "" {
+			targets = append(targets, logTarget{namespace: pod.Namespace, pod: pod.Name, container: inputs.Container})
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			targets = append(targets, logTarget{namespace: pod.Namespace, pod: pod.Name, container: container.Name})
+		}
+	}
+	return targets, nil
+}
+
+// compareLogMatches compares the count of the matching lines with the expected criteria and returns the description
+func compareLogMatches(probe v1alpha1.ProbeAttributes, inputs *logProbeInputs, matches *logMatches, rc int) (string, error) {
+	count := strconv.Itoa(matches.count(inputs.per))
+	if err := cmp.RunCount(rc).
+		FirstValue(count).
+		SecondValue(inputs.Comparator.Value).
+		Criteria(inputs.Comparator.Criteria).
+		ProbeName(probe.Name).
+		ProbeVerbosity(probe.RunProperties.Verbosity).
+		CompareInt(cerrors.FailureTypeLogProbe); err != nil {
+		return "", err
+	}
+	if inputs.per != 0 {
+		return fmt.Sprintf("The logs contain at most %s lines matching the %q pattern per %s. Expected count: %s %s", count, inputs.Pattern, inputs.Per, inputs.Comparator.Criteria, inputs.Comparator.Value), nil
+	}
+	return fmt.Sprintf("The logs contain %s lines matching the %q pattern. Expected count: %s %s", count, inputs.Pattern, inputs.Comparator.Criteria, inputs.Comparator.Value), nil
+}
+
+func (m *logMatches) add(t time.Time) {
+	m.Lock()
+	defer m.Unlock()
+	m.times = append(m.times, t)
+}
+
+// count returns the count of the matching lines, or the highest count within any window of the given duration
+func (m *logMatches) count(per time.Duration) int {
+	m.Lock()
+	defer m.Unlock()
+	if per == 0 {
+		return len(m.times)
+	}
+
+	times := append([]time.Time{}, m.times...)
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	var highest int
+	for start, end := 0, 0; end < len(times); end++ {
+		for times[end].Sub(times[start]) >= per {
+			start++
+		}
+		highest = math.Maximum(highest, end-start+1)
+	}
+	return highest
+}
+
+// triggerContinuousLogProbe follows the logs till the end of the chaos
+func triggerContinuousLogProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosresult *types.ResultDetails, chaosDetails *types.ChaosDetails) {
+	probeTimeout := getProbeTimeouts(probe.Name, chaosresult.ProbeDetails)
+	// waiting for initial delay
+	if probeTimeout.InitialDelay != 0 {
+		log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+		time.Sleep(probeTimeout.InitialDelay)
+	}
+
+	err := streamLogProbe(chaosDetails.ProbeContext.Ctx, probe, clients, chaosresult, chaosDetails)
+	log.Infof("Stopping %s continuous Probe", probe.Name)
+	recordLogProbeResult(err, probe, clients, chaosresult, chaosDetails)
+}
+
+// triggerOnChaosLogProbe follows the logs for the chaos duration
+func triggerOnChaosLogProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosresult *types.ResultDetails, chaosDetails *types.ChaosDetails) {
+	probeTimeout := getProbeTimeouts(probe.Name, chaosresult.ProbeDetails)
+
+	duration := chaosDetails.ChaosDuration
+	// waiting for initial delay
+	if probeTimeout.InitialDelay != 0 {
+		log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+		time.Sleep(probeTimeout.InitialDelay)
+		duration = math.Maximum(0, duration-int(probeTimeout.InitialDelay.Seconds()))
+	}
+
+	ctx, cancel := context.WithTimeout(chaosDetails.ProbeContext.Ctx, time.Duration(duration)*time.Second)
+	defer cancel()
+	err := streamLogProbe(ctx, probe, clients, chaosresult, chaosDetails)
+	log.Infof("[Chaos]: Time is up for the %v probe", probe.Name)
+	recordLogProbeResult(err, probe, clients, chaosresult, chaosDetails)
+}
+
+// recordLogProbeResult marks the completion of the continuous or the onchaos log probe and records the error inside the probeDetails, if any
+func recordLogProbeResult(err error, probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosresult *types.ResultDetails, chaosDetails *types.ChaosDetails) {
+	if err != nil {
+		err = addProbePhase(err, string(chaosDetails.Phase))
+		log.Errorf("The %v log probe has been Failed, err: %v", probe.Name, err)
+	}
+	for index := range chaosresult.ProbeDetails {
+		if chaosresult.ProbeDetails[index].Name == probe.Name {
+			if err != nil {
+				chaosresult.ProbeDetails[index].IsProbeFailedWithError = err
+				chaosresult.ProbeDetails[index].Status.Description = getDescription(err)
+			}
+			chaosresult.ProbeDetails[index].HasProbeCompleted = true
+		}
+	}
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
+	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
+	// and failed the experiment in the end
+	if err != nil && probe.RunProperties.StopOnFailure {
+		if err := stopChaosEngine(probe, clients, chaosresult, chaosDetails); err != nil {
+			log.Errorf("Unable to patch chaosengine to stop, err: %v", err)
+		}
+	}
+}
+
+// preChaosLogProbe trigger the log probe for prechaos phase
+func preChaosLogProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	switch probe.Mode {
+	case "SOT", "Edge":
+
+		//DISPLAY THE LOG PROBE INFO
+		log.InfoWithValues("[Probe]: The log probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PreChaos",
+		})
+
+		// waiting for initial delay
+		if probeTimeout.InitialDelay != 0 {
+			log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+			time.Sleep(probeTimeout.InitialDelay)
+		}
+		// trigger the log probe
+		if err = triggerLogProbe(probe, clients, resultDetails, chaosDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeLogProbe {
+			return err
+		}
+
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		// it will update the status of all the unrun probes as well
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PreChaos"); err != nil {
+			return err
+		}
+	case "Continuous":
+
+		//DISPLAY THE LOG PROBE INFO
+		log.InfoWithValues("[Probe]: The log probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PreChaos",
+		})
+
+		go triggerContinuousLogProbe(probe, clients, resultDetails, chaosDetails)
+	}
+	return nil
+}
+
+// postChaosLogProbe trigger the log probe for postchaos phase
+func postChaosLogProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	switch probe.Mode {
+	case "EOT", "Edge":
+
+		//DISPLAY THE LOG PROBE INFO
+		log.InfoWithValues("[Probe]: The log probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PostChaos",
+		})
+
+		// waiting for initial delay
+		if probeTimeout.InitialDelay != 0 {
+			log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+			time.Sleep(probeTimeout.InitialDelay)
+		}
+
+		// trigger the log probe
+		if err = triggerLogProbe(probe, clients, resultDetails, chaosDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeLogProbe {
+			return err
+		}
+
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		// it will update the status of all the unrun probes as well
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PostChaos"); err != nil {
+			return err
+		}
+	case "Continuous", "OnChaos":
+		// it will check for the error, It will detect the error if any error encountered in probe during chaos
+		if err = checkForErrorInContinuousProbe(resultDetails, probe.Name, chaosDetails.Delay, chaosDetails.Timeout); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeLogProbe && cerrors.GetErrorType(err) != cerrors.FailureTypeProbeTimeout {
+			return err
+		}
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PostChaos"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// onChaosLogProbe trigger the log probe for DuringChaos phase
+func onChaosLogProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) {
+
+	switch probe.Mode {
+	case "OnChaos":
+
+		//DISPLAY THE LOG PROBE INFO
+		log.InfoWithValues("[Probe]: The log probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "DuringChaos",
+		})
+		go triggerOnChaosLogProbe(probe, clients, resultDetails, chaosDetails)
+	}
+}
@@ -0,0 +1,150 @@
+package probe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+func TestScanLogs(t *testing.T) {
+	logs := `2026-01-01T10:00:00.000000000Z connection refused
+2026-01-01T10:00:20.000000000Z reconnected to primary
+2026-01-01T10:00:30.000000000Z connection refused
+2026-01-01T10:00:50.000000000Z connection refused
+2026-01-01T10:01:10.000000000Z connection refused
+`
+	matches := &logMatches{}
+	last, err := scanLogs(strings.NewReader(logs), regexp.MustCompile("connection refused"), matches, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-01-01T10:01:10Z", last.Format(time.RFC3339))
+	assert.Equal(t, 4, matches.count(0))
+	assert.Equal(t, 3, matches.count(time.Minute))
+	assert.Equal(t, 1, matches.count(10*time.Second))
+
+	// the lines which are already read are skipped
+	after, _ := time.Parse(time.RFC3339, "2026-01-01T10:00:30Z")
+	matches = &logMatches{}
+	_, err = scanLogs(strings.NewReader(logs), regexp.MustCompile("connection refused"), matches, after)
+	require.NoError(t, err)
+	assert.Equal(t, 2, matches.count(0))
+}
+
+// newLogProbeClients returns the clients of a fake api server, which contains two pods with the same logs
+func newLogProbeClients(t *testing.T) clients.ClientSets {
+	pods := corev1.PodList{}
+	for _, name := range []string{"orders-1", "orders-2"} {
+		pods.Items = append(pods.Items, corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{Name: name, Namespace: "shop", Labels: map[string]string{"app": "orders"}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "orders"}}},
+		})
+	}
+	now := time.Now().UTC()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/log") {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		switch r.URL.Path {
+		case "/api/v1/namespaces/shop/pods":
+			json.NewEncoder(w).Encode(pods)
+		case "/api/v1/namespaces/shop/pods/orders-1":
+			json.NewEncoder(w).Encode(pods.Items[0])
+		case "/api/v1/namespaces/shop/pods/orders-1/log", "/api/v1/namespaces/shop/pods/orders-2/log":
+			fmt.Fprintf(w, "%s connection refused\n%s reconnected to primary\n", now.Add(-30*time.Second).Format(time.RFC3339Nano), now.Format(time.RFC3339Nano))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(v1.Status{Status: v1.StatusFailure, Reason: v1.StatusReasonNotFound, Code: http.StatusNotFound})
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	kubeClient, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	return clients.ClientSets{KubeClient: kubeClient}
+}
+
+func TestTriggerLogProbe(t *testing.T) {
+	clientSets := newLogProbeClients(t)
+	chaosDetails := &types.ChaosDetails{ChaosDuration: 60, AppDetail: []types.AppDetails{{Kind: "deployment", Namespace: "shop", Labels: []string{"app=orders"}}}}
+
+	tests := []struct {
+		name    string
+		data    string
+		failure bool
+	}{
+		{name: "contains the pattern", data: "pattern: reconnected to primary"},
+		{name: "doesn't contain the pattern", data: "pattern: failed over", failure: true},
+		{name: "count of the matching lines", data: "pattern: connection refused\ncomparator:\n  criteria: ==\n  value: \"2\""},
+		{name: "matching lines per minute", data: "pattern: connection refused\nper: 1m\ncomparator:\n  criteria: <\n  value: \"2\"", failure: true},
+		{name: "matching lines per second", data: "pattern: connection refused|reconnected\nper: 1s\ncomparator:\n  criteria: <=\n  value: \"2\""},
+		{name: "named pods", data: "pattern: reconnected\nnamespace: shop\npods: [orders-1]\ncontainer: orders"},
+		{name: "missing pods", data: "pattern: reconnected\nnamespace: shop\npods: [orders-3]", failure: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe, resultDetails := newTestProbe("check-logs", "logProbe", "EOT", tt.data, types.ProbeTimeouts{ProbeTimeout: 5 * time.Second})
+			assertProbeError(t, triggerLogProbe(probe, clientSets, resultDetails, chaosDetails), tt.failure, cerrors.FailureTypeLogProbe)
+		})
+	}
+}
+
+func TestStreamLogProbe(t *testing.T) {
+	clientSets := newLogProbeClients(t)
+	chaosDetails := &types.ChaosDetails{AppDetail: []types.AppDetails{{Kind: "deployment", Namespace: "shop", Labels: []string{"app=orders"}}}}
+
+	tests := []struct {
+		name    string
+		data    string
+		failure bool
+	}{
+		// the streams are reopened on every polling interval, the lines which are already read are not counted again
+		{name: "count of the matching lines", data: "pattern: connection refused\ncomparator:\n  criteria: ==\n  value: \"2\""},
+		{name: "upper bound", data: "pattern: connection refused\ncomparator:\n  criteria: <\n  value: \"2\"", failure: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe, resultDetails := newTestProbe("check-logs", "logProbe", "Continuous", tt.data, types.ProbeTimeouts{ProbePollingInterval: 100 * time.Millisecond})
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			start := time.Now()
+			err := streamLogProbe(ctx, probe, clientSets, resultDetails, chaosDetails)
+			assertProbeError(t, err, tt.failure, cerrors.FailureTypeLogProbe)
+			if tt.failure {
+				assert.Less(t, time.Since(start), time.Second)
+			}
+		})
+	}
+}
+
+func TestParseLogProbeInputs(t *testing.T) {
+	inputs, err := parseLogProbeInputs(v1alpha1.ProbeAttributes{Data: "pattern: error"}, &types.ChaosDetails{ChaosDuration: 30, ChaosNamespace: "litmus"})
+	require.NoError(t, err)
+	assert.Equal(t, "litmus", inputs.Namespace)
+	assert.Equal(t, 30*time.Second, inputs.window)
+	assert.Equal(t, ">=", inputs.Comparator.Criteria)
+
+	for _, data := range []string{"", "pattern: '('", "pattern: error\nper: minute", "pattern: error\nwindow: -1m", "pattern: error\nfollow: true"} {
+		_, err := parseLogProbeInputs(v1alpha1.ProbeAttributes{Data: data}, &types.ChaosDetails{})
+		assert.Error(t, err, data)
+	}
+}
@@ -0,0 +1,434 @@
+package probe
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/math"
+	cmp "github.com/litmuschaos/litmus-go/pkg/probe/comparator"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/retry"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// defaultNetProbeTimeout is the connect timeout of the net probe, if the probeTimeout is not provided
+const defaultNetProbeTimeout = 5 * time.Second
+
+// netProbeInputs contains the inputs of the net probe
+type netProbeInputs struct {
+	// Address is the host:port of the dependency, it supports the templates
+	Address string `json:"address"`
+	// Protocol is either tcp or udp, it defaults to tcp
+	Protocol string `json:"protocol,omitempty"`
+	// Criteria is either reachable or unreachable, it defaults to reachable
+	Criteria string `json:"criteria,omitempty"`
+	// Payload is the datagram sent to the udp address, the address is reachable once any response is received
+	Payload string `json:"payload,omitempty"`
+	// Latency compares the connect latency in milliseconds with the float criteria, such as <= 100
+	Latency *v1alpha1.ComparatorInfo `json:"latency,omitempty"`
+}
+
+// prepareNetProbe contains the steps to prepare the net probe
+// net probe can be used to add the probe which will check whether the host and port accept the tcp connections or respond to the udp datagrams
+func prepareNetProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails, phase string) error {
+
+	switch strings.ToLower(phase) {
+	case "prechaos":
+		if err := preChaosNetProbe(probe, resultDetails, clients, chaosDetails); err != nil {
+			return err
+		}
+	case "postchaos":
+		if err := postChaosNetProbe(probe, resultDetails, chaosDetails.Delay, chaosDetails.Timeout); err != nil {
+			return err
+		}
+	case "duringchaos":
+		onChaosNetProbe(probe, resultDetails, clients, chaosDetails)
+	default:
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeNetProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("phase '%s' not supported in the net probe", phase)}
+	}
+	return nil
+}
+
+// parseNetProbeInputs parses the inputs of the net probe from the data field and fills in the defaults
+func parseNetProbeInputs(probe v1alpha1.ProbeAttributes) (*netProbeInputs, error) {
+	inputs := &netProbeInputs{}
+	if err := yaml.UnmarshalStrict([]byte(probe.Data), inputs); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeNetProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("failed to parse the net probe inputs: %s", err.Error())}
+	}
+	if _, _, err := net.SplitHostPort(inputs.Address); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeNetProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("invalid address %q, expected host:port", inputs.Address)}
+	}
+
+	inputs.Protocol = strings.ToLower(inputs.Protocol)
+	if inputs.Protocol == "" {
+		inputs.Protocol = "tcp"
+	}
+	inputs.Criteria = strings.ToLower(inputs.Criteria)
+	if inputs.Criteria == "" {
+		inputs.Criteria = "reachable"
+	}
+	switch {
+	case inputs.Protocol != "tcp" && inputs.Protocol != "udp":
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeNetProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("protocol '%s' not supported in the net probe", inputs.Protocol)}
+	case inputs.Criteria != "reachable" && inputs.Criteria != "unreachable":
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeNetProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("criteria '%s' not supported in the net probe", inputs.Criteria)}
+	case inputs.Latency != nil && inputs.Criteria == "unreachable":
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeNetProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: "latency can't be compared for the unreachable criteria"}
+	}
+	return inputs, nil
+}
+
+// triggerNetProbe connects to the address and verify the reachability and the connect latency to follow the specified criteria
+func triggerNetProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	inputs, err := parseNetProbeInputs(probe)
+	if err != nil {
+		return err
+	}
+	// It parses the templated address and return normal string
+	// if address doesn't have template, it will return the same address
+	if inputs.Address, err = parseCommand(inputs.Address, resultDetails); err != nil {
+		return err
+	}
+
+	timeout := probeTimeout.ProbeTimeout
+	if timeout == 0 {
+		timeout = defaultNetProbeTimeout
+	}
+
+	log.InfoWithValues("[Probe]: Net probe informations", logrus.Fields{
+		"Name":            probe.Name,
+		"Address":         inputs.Address,
+		"Protocol":        inputs.Protocol,
+		"Criteria":        inputs.Criteria,
+		"Latency":         inputs.Latency,
+		"ResponseTimeout": timeout,
+	})
+
+	var description string
+
+	// it will retry for some retry count, in each iteration of try it contains following things
+	// it contains a timeout per iteration of retry. if the timeout expires without success then it will go to next try
+	// for a timeout, it will connect to the address, if it fails wait for the interval and again connect until timeout expires
+	if err := retry.Times(uint(getAttempts(probe.RunProperties.Attempt, probe.RunProperties.Retry))).
+		Wait(probeTimeout.Interval).
+		Try(func(attempt uint) error {
+			latency, connErr := connect(inputs, timeout)
+			rc := getAndIncrementRunCount(resultDetails, probe.Name)
+
+			reachable := "reachable"
+			if connErr != nil {
+				reachable = "unreachable"
+				log.Infof("[Probe]: The %v address is unreachable, err: %v", inputs.Address, connErr)
+			}
+
+			// comparing the reachability with the expected criteria
+			if err := cmp.RunCount(rc).
+				FirstValue(reachable).
+				SecondValue(inputs.Criteria).
+				Criteria("equal").
+				ProbeName(probe.Name).
+				ProbeVerbosity(probe.RunProperties.Verbosity).
+				CompareString(cerrors.FailureTypeNetProbe); err != nil {
+				log.Errorf("The %v net probe has Failed, err: %v", probe.Name, err)
+				return err
+			}
+			if connErr != nil {
+				description = fmt.Sprintf("The %s address %s is unreachable as expected", inputs.Protocol, inputs.Address)
+				return nil
+			}
+
+			ms := strconv.FormatFloat(float64(latency.Microseconds())/1000, 'f', 3, 64)
+			description = fmt.Sprintf("The %s address %s is reachable. Connect latency: %sms", inputs.Protocol, inputs.Address, ms)
+			if inputs.Latency == nil {
+				return nil
+			}
+
+			// comparing the connect latency with the expected criteria
+			if err := cmp.RunCount(rc).
+				FirstValue(ms).
+				SecondValue(inputs.Latency.Value).
+				Criteria(inputs.Latency.Criteria).
+				ProbeName(probe.Name).
+				ProbeVerbosity(probe.RunProperties.Verbosity).
+				CompareFloat(cerrors.FailureTypeNetProbe); err != nil {
+				log.Errorf("The %v net probe has Failed, err: %v", probe.Name, err)
+				return err
+			}
+			description = fmt.Sprintf("%s. Expected latency: %s %sms", description, inputs.Latency.Criteria, inputs.Latency.Value)
+			return nil
+		}); err != nil {
+		return err
+	}
+	setProbeDescription(resultDetails, probe, description)
+	return nil
+}
+
+// connect connects to the address within the timeout and returns the connect latency
+// the tcp latency is the time to establish the connection, the udp latency is the round trip time of the payload
+func connect(inputs *netProbeInputs, timeout time.Duration) (time.Duration, error) {
+	start := time.Now()
+	conn, err := net.DialTimeout(inputs.Protocol, inputs.Address, timeout)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	if inputs.Protocol == "tcp" {
+		return time.Since(start), nil
+	}
+
+	// udp is connectionless, so the address is reachable only if it responds to the payload
+	if err := conn.SetDeadline(start.Add(timeout)); err != nil {
+		return 0, err
+	}
+	if _, err := conn.Write([]byte(inputs.Payload)); err != nil {
+		return 0, err
+	}
+	if _, err := conn.Read(make([]byte, 65535)); err != nil {
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return 0, fmt.Errorf("no response received within %v", timeout)
+		}
+		return 0, err
+	}
+	return time.Since(start), nil
+}
+
+// triggerContinuousNetProbe trigger the continuous net probes
+func triggerContinuousNetProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosresult *types.ResultDetails, chaosDetails *types.ChaosDetails) {
+	probeTimeout := getProbeTimeouts(probe.Name, chaosresult.ProbeDetails)
+	var isExperimentFailed bool
+	// waiting for initial delay
+	if probeTimeout.InitialDelay != 0 {
+		log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+		time.Sleep(probeTimeout.InitialDelay)
+	}
+
+	// it triggers the net probe for the entire duration of chaos and it fails, if any error encounter
+	// it marked the error for the probes, if any
+loop:
+	for {
+		select {
+		case <-chaosDetails.ProbeContext.Ctx.Done():
+			log.Infof("Stopping %s continuous Probe", probe.Name)
+			for index := range chaosresult.ProbeDetails {
+				if chaosresult.ProbeDetails[index].Name == probe.Name {
+					chaosresult.ProbeDetails[index].HasProbeCompleted = true
+				}
+			}
+			break loop
+		default:
+			err = triggerNetProbe(probe, chaosresult)
+			// record the error inside the probeDetails, we are maintaining a dedicated variable for the err, inside probeDetails
+			if err != nil {
+				err = addProbePhase(err, string(chaosDetails.Phase))
+				for index := range chaosresult.ProbeDetails {
+					if chaosresult.ProbeDetails[index].Name == probe.Name {
+						chaosresult.ProbeDetails[index].IsProbeFailedWithError = err
+						chaosresult.ProbeDetails[index].HasProbeCompleted = true
+						chaosresult.ProbeDetails[index].Status.Description = getDescription(err)
+						log.Errorf("The %v net probe has been Failed, err: %v", probe.Name, err)
+						isExperimentFailed = true
+						break loop
+					}
+				}
+			}
+			// waiting for the probe polling interval
+			time.Sleep(probeTimeout.ProbePollingInterval)
+		}
+	}
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
+	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
+	// and failed the experiment in the end
+	if isExperimentFailed && probe.RunProperties.StopOnFailure {
+		if err := stopChaosEngine(probe, clients, chaosresult, chaosDetails); err != nil {
+			log.Errorf("Unable to patch chaosengine to stop, err: %v", err)
+		}
+	}
+}
+
+// preChaosNetProbe trigger the net probe for prechaos phase
+func preChaosNetProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	switch probe.Mode {
+	case "SOT", "Edge":
+
+		//DISPLAY THE Net PROBE INFO
+		log.InfoWithValues("[Probe]: The net probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PreChaos",
+		})
+
+		// waiting for initial delay
+		if probeTimeout.InitialDelay != 0 {
+			log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+			time.Sleep(probeTimeout.InitialDelay)
+		}
+		// trigger the net probe
+		if err = triggerNetProbe(probe, resultDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeNetProbe {
+			return err
+		}
+
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		// it will update the status of all the unrun probes as well
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PreChaos"); err != nil {
+			return err
+		}
+	case "Continuous":
+
+		//DISPLAY THE Net PROBE INFO
+		log.InfoWithValues("[Probe]: The net probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PreChaos",
+		})
+
+		go triggerContinuousNetProbe(probe, clients, resultDetails, chaosDetails)
+	}
+	return nil
+}
+
+// postChaosNetProbe trigger the net probe for postchaos phase
+func postChaosNetProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, delay int, timeout int) error {
+	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
+
+	switch probe.Mode {
+	case "EOT", "Edge":
+
+		//DISPLAY THE Net PROBE INFO
+		log.InfoWithValues("[Probe]: The net probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "PostChaos",
+		})
+
+		// waiting for initial delay
+		if probeTimeout.InitialDelay != 0 {
+			log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+			time.Sleep(probeTimeout.InitialDelay)
+		}
+
+		// trigger the net probe
+		if err = triggerNetProbe(probe, resultDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeNetProbe {
+			return err
+		}
+
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		// it will update the status of all the unrun probes as well
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PostChaos"); err != nil {
+			return err
+		}
+	case "Continuous", "OnChaos":
+		// it will check for the error, It will detect the error if any error encountered in probe during chaos
+		if err = checkForErrorInContinuousProbe(resultDetails, probe.Name, delay, timeout); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypeNetProbe && cerrors.GetErrorType(err) != cerrors.FailureTypeProbeTimeout {
+			return err
+		}
+		// failing the probe, if the success condition doesn't met after the retry & timeout combinations
+		if err = markedVerdictInEnd(err, resultDetails, probe, "PostChaos"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// triggerOnChaosNetProbe trigger the onchaos net probes
+func triggerOnChaosNetProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosresult *types.ResultDetails, chaosDetails *types.ChaosDetails) {
+	probeTimeout := getProbeTimeouts(probe.Name, chaosresult.ProbeDetails)
+
+	var isExperimentFailed bool
+	duration := chaosDetails.ChaosDuration
+	// waiting for initial delay
+	if probeTimeout.InitialDelay != 0 {
+		log.Infof("[Wait]: Waiting for %v before probe execution", probe.RunProperties.InitialDelay)
+		time.Sleep(probeTimeout.InitialDelay)
+		duration = math.Maximum(0, duration-int(probeTimeout.InitialDelay.Seconds()))
+	}
+
+	endTime := time.After(time.Duration(duration) * time.Second)
+
+	// it trigger the net probe for the entire duration of chaos and it fails, if any error encounter
+	// it marked the error for the probes, if any
+loop:
+	for {
+		select {
+		case <-endTime:
+			log.Infof("[Chaos]: Time is up for the %v probe", probe.Name)
+			endTime = nil
+			for index := range chaosresult.ProbeDetails {
+				if chaosresult.ProbeDetails[index].Name == probe.Name {
+					chaosresult.ProbeDetails[index].HasProbeCompleted = true
+				}
+			}
+			break loop
+		default:
+			err = triggerNetProbe(probe, chaosresult)
+			// record the error inside the probeDetails, we are maintaining a dedicated variable for the err, inside probeDetails
+			if err != nil {
+				err = addProbePhase(err, string(chaosDetails.Phase))
+				for index := range chaosresult.ProbeDetails {
+					if chaosresult.ProbeDetails[index].Name == probe.Name {
+						chaosresult.ProbeDetails[index].IsProbeFailedWithError = err
+						chaosresult.ProbeDetails[index].HasProbeCompleted = true
+						chaosresult.ProbeDetails[index].Status.Description = getDescription(err)
+						isExperimentFailed = true
+						break loop
+					}
+				}
+			}
+
+			select {
+			case <-chaosDetails.ProbeContext.Ctx.Done():
+				log.Infof("Stopping %s continuous Probe", probe.Name)
+				for index := range chaosresult.ProbeDetails {
+					if chaosresult.ProbeDetails[index].Name == probe.Name {
+						chaosresult.ProbeDetails[index].HasProbeCompleted = true
+					}
+				}
+				break loop
+			default:
+				// waiting for the probe polling interval
+				time.Sleep(probeTimeout.ProbePollingInterval)
+			}
+		}
+	}
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
+	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
+	// and failed the experiment in the end
+	if isExperimentFailed && probe.RunProperties.StopOnFailure {
+		if err := stopChaosEngine(probe, clients, chaosresult, chaosDetails); err != nil {
+			log.Errorf("unable to patch chaosengine to stop, err: %v", err)
+		}
+	}
+}
+
+// onChaosNetProbe trigger the net probe for DuringChaos phase
+func onChaosNetProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) {
+
+	switch probe.Mode {
+	case "OnChaos":
+
+		//DISPLAY THE Net PROBE INFO
+		log.InfoWithValues("[Probe]: The net probe information is as follows", logrus.Fields{
+			"Name":           probe.Name,
+			"Run Properties": probe.RunProperties,
+			"Mode":           probe.Mode,
+			"Phase":          "DuringChaos",
+		})
+		go triggerOnChaosNetProbe(probe, clients, resultDetails, chaosDetails)
+	}
+}
@@ -0,0 +1,68 @@
+package probe
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+func TestTriggerNetProbe(t *testing.T) {
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer tcp.Close()
+
+	udp, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer udp.Close()
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, addr, err := udp.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			udp.WriteTo(buf[:n], addr)
+		}
+	}()
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := closed.Addr().String()
+	closed.Close()
+
+	tests := []struct {
+		name    string
+		data    string
+		failure bool
+	}{
+		{name: "tcp reachable", data: "address: " + tcp.Addr().String()},
+		{name: "tcp latency", data: "address: " + tcp.Addr().String() + "\nlatency:\n  criteria: <=\n  value: \"1000\""},
+		{name: "tcp latency breached", data: "address: " + tcp.Addr().String() + "\nlatency:\n  criteria: '>='\n  value: \"100000\"", failure: true},
+		{name: "tcp unreachable", data: "address: " + closedAddr, failure: true},
+		{name: "tcp expected unreachable", data: "address: " + closedAddr + "\ncriteria: unreachable"},
+		{name: "udp reachable", data: "address: " + udp.LocalAddr().String() + "\nprotocol: udp\npayload: ping"},
+		{name: "udp unreachable", data: "address: " + udp.LocalAddr().String() + "\nprotocol: udp\ncriteria: unreachable\npayload: ping", failure: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe, resultDetails := newTestProbe("check-redis", "netProbe", "SOT", tt.data, types.ProbeTimeouts{ProbeTimeout: time.Second})
+			assertProbeError(t, triggerNetProbe(probe, resultDetails), tt.failure, cerrors.FailureTypeNetProbe)
+		})
+	}
+}
+
+func TestParseNetProbeInputs(t *testing.T) {
+	for _, data := range []string{"", "address: redis", "address: redis:6379\nprotocol: icmp", "address: redis:6379\ncriteria: slow",
+		"address: redis:6379\ncriteria: unreachable\nlatency:\n  criteria: <=\n  value: \"10\""} {
+		_, err := parseNetProbeInputs(v1alpha1.ProbeAttributes{Data: data})
+		assert.Error(t, err, data)
+	}
+}
@@ -1,3 +1,8 @@
+// Package probe runs the probes of the chaosengine and marks their verdicts in the chaosresult
+//
+// The chaosengine only has dedicated fields for the inputs of the original probes. The inputs of the
+// newer probes, such as the dns, grpc, log and net probes, are provided as YAML inside the data field
+// of the probe and are decoded strictly, so that a misspelt input fails the probe instead of being ignored.
 package probe
 
 import (
@@ -10,6 +15,7 @@ import (
 
 	"github.com/kyokomi/emoji"
 	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/abort"
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
 	"github.com/litmuschaos/litmus-go/pkg/clients"
 	"github.com/litmuschaos/litmus-go/pkg/log"
@@ -29,6 +35,12 @@ func RunProbes(ctx context.Context, chaosDetails *types.ChaosDetails, clients cl
 	ctx, span := otel.Tracer(telemetry.TracerName).Start(ctx, "RunProbes")
 	defer span.End()
 
+	// the probes may have side effects, so they are skipped in dry-run mode
+	if chaosDetails.DryRun {
+		log.Infof("[DryRun]: Skipping the %v probes", phase)
+		return nil
+	}
+
 	// get the probes details from the chaosengine
 	probes, err := getProbesFromChaosEngine(chaosDetails, clients)
 	if err != nil {
@@ -129,6 +141,10 @@ func SetProbeVerdictAfterFailure(result *v1alpha1.ChaosResult) {
 }
 
 func getProbesFromChaosEngine(chaosDetails *types.ChaosDetails, clients clients.ClientSets) ([]v1alpha1.ProbeAttributes, error) {
+	// the probes are defined inside the scenario in standalone mode
+	if chaosDetails.EngineName == "" {
+		return chaosDetails.Probes, nil
+	}
 	engine, err := clients.GetChaosEngine(chaosDetails)
 	if err != nil {
 		return nil, err
@@ -212,6 +228,7 @@ func markedVerdictInEnd(err error, resultDetails *types.ResultDetails, probe v1a
 	}
 
 	setProbeVerdict(resultDetails, probe, probeVerdict, description, phase)
+	telemetry.RecordProbeRun(probe.Name, probe.Type, phase, string(probeVerdict))
 
 	if err != nil {
 		switch probe.RunProperties.StopOnFailure {
@@ -289,6 +306,38 @@ loop:
 	return nil
 }
 
+// ResetOnChaosProbes marks the onchaos probes as incomplete and clears their errors, before they are run again for the next step of the ramp mode
+func ResetOnChaosProbes(resultDetails *types.ResultDetails) {
+	for index := range resultDetails.ProbeDetails {
+		if strings.ToLower(resultDetails.ProbeDetails[index].Mode) == "onchaos" {
+			resultDetails.ProbeDetails[index].HasProbeCompleted = false
+			resultDetails.ProbeDetails[index].IsProbeFailedWithError = nil
+		}
+	}
+}
+
+// EvaluateStep waits for the onchaos probes of the current step of the ramp mode to complete
+// it returns the error of the failed onchaos and continuous probes, if any
+func EvaluateStep(resultDetails *types.ResultDetails, delay, timeout int) error {
+	var probeError []string
+	for _, probe := range resultDetails.ProbeDetails {
+		switch strings.ToLower(probe.Mode) {
+		case "onchaos":
+			if err := checkForErrorInContinuousProbe(resultDetails, probe.Name, delay, timeout); err != nil {
+				probeError = append(probeError, stacktrace.RootCause(err).Error())
+			}
+		case "continuous":
+			if probe.IsProbeFailedWithError != nil {
+				probeError = append(probeError, stacktrace.RootCause(probe.IsProbeFailedWithError).Error())
+			}
+		}
+	}
+	if len(probeError) != 0 {
+		return cerrors.PreserveError{ErrString: fmt.Sprintf("[%s]", strings.Join(probeError, ","))}
+	}
+	return nil
+}
+
 // ParseCommand parse the templated command and replace the templated value by actual value
 // if command doesn't have template, it will return the same command
 func parseCommand(templatedCommand string, resultDetails *types.ResultDetails) (string, error) {
@@ -306,7 +355,8 @@ func parseCommand(templatedCommand string, resultDetails *types.ResultDetails) (
 	return out.String(), nil
 }
 
-// stopChaosEngine update the probe status and patch the chaosengine to stop state
+// stopChaosEngine update the probe status and abort the experiment, so that the chaos is reverted right away
+// the chaosengine is patched to stop state, if the abort isn't handled within the abort timeout
 func stopChaosEngine(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosresult *types.ResultDetails, chaosDetails *types.ChaosDetails) error {
 	// it will check for the error, It will detect the error if any error encountered in probe during chaos
 	if err = checkForErrorInContinuousProbe(chaosresult, probe.Name, chaosDetails.Timeout, chaosDetails.Delay); err != nil && cerrors.GetErrorType(err) == cerrors.FailureTypeProbeTimeout {
@@ -315,6 +365,18 @@ func stopChaosEngine(probe v1alpha1.ProbeAttributes, clients clients.ClientSets,
 
 	// failing the probe, if the success condition doesn't met after the retry & timeout combinations
 	markedVerdictInEnd(err, chaosresult, probe, "PostChaos")
+
+	log.Errorf("[Abort]: The %v probe has been failed, reverting the chaos", probe.Name)
+	abort.Trigger(fmt.Sprintf("%v probe has been failed", probe.Name))
+	if abort.Wait(time.Duration(chaosDetails.AbortTimeout) * time.Second) {
+		return nil
+	}
+	log.Errorf("[Abort]: The abort is not handled within %vs, stopping the chaosengine", chaosDetails.AbortTimeout)
+
+	// there is no chaosengine to stop in standalone mode
+	if chaosDetails.EngineName == "" {
+		return nil
+	}
 	//patch chaosengine's state to stop
 	engine, err := clients.LitmusClient.ChaosEngines(chaosDetails.ChaosNamespace).Get(context.Background(), chaosDetails.EngineName, v1.GetOptions{})
 	if err != nil {
@@ -347,6 +409,26 @@ func execute(probe v1alpha1.ProbeAttributes, chaosDetails *types.ChaosDetails, c
 		if err = prepareHTTPProbe(probe, clients, chaosDetails, resultDetails, phase); err != nil {
 			return stacktrace.Propagate(err, "probes failed")
 		}
+	case "grpcprobe":
+		// it contains steps to prepare grpc probe
+		if err = prepareGRPCProbe(probe, clients, chaosDetails, resultDetails, phase); err != nil {
+			return stacktrace.Propagate(err, "probes failed")
+		}
+	case "netprobe":
+		// it contains steps to prepare net probe
+		if err = prepareNetProbe(probe, clients, chaosDetails, resultDetails, phase); err != nil {
+			return stacktrace.Propagate(err, "probes failed")
+		}
+	case "dnsprobe":
+		// it contains steps to prepare dns probe
+		if err = prepareDNSProbe(probe, clients, chaosDetails, resultDetails, phase); err != nil {
+			return stacktrace.Propagate(err, "probes failed")
+		}
+	case "logprobe":
+		// it contains steps to prepare log probe
+		if err = prepareLogProbe(probe, clients, chaosDetails, resultDetails, phase); err != nil {
+			return stacktrace.Propagate(err, "probes failed")
+		}
 	case "promprobe":
 		// it contains steps to prepare prom probe
 		if err = preparePromProbe(probe, clients, chaosDetails, resultDetails, phase); err != nil {
@@ -388,7 +470,9 @@ func getAttempts(attempt, retries int) int {
 
 func IsProbeFailed(reason string) bool {
 	if strings.Contains(reason, string(cerrors.FailureTypeK8sProbe)) || strings.Contains(reason, string(cerrors.FailureTypePromProbe)) ||
-		strings.Contains(reason, string(cerrors.FailureTypeCmdProbe)) || strings.Contains(reason, string(cerrors.FailureTypeHttpProbe)) {
+		strings.Contains(reason, string(cerrors.FailureTypeCmdProbe)) || strings.Contains(reason, string(cerrors.FailureTypeHttpProbe)) ||
+		strings.Contains(reason, string(cerrors.FailureTypeGRPCProbe)) || strings.Contains(reason, string(cerrors.FailureTypeNetProbe)) ||
+		strings.Contains(reason, string(cerrors.FailureTypeDNSProbe)) || strings.Contains(reason, string(cerrors.FailureTypeLogProbe)) {
 		return true
 	}
 	return false
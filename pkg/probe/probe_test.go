@@ -0,0 +1,77 @@
+package probe
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+// newTestProbe returns the probe along with the chaosresult details that track it
+func newTestProbe(name, probeType, mode, data string, timeouts types.ProbeTimeouts) (v1alpha1.ProbeAttributes, *types.ResultDetails) {
+	probe := v1alpha1.ProbeAttributes{Name: name, Type: probeType, Mode: mode, Data: data}
+	resultDetails := &types.ResultDetails{ProbeDetails: []*types.ProbeDetails{{Name: name, Type: probeType, Mode: mode, Timeouts: timeouts}}}
+	return probe, resultDetails
+}
+
+// assertProbeError asserts that the probe has passed, or has failed with the given error type
+func assertProbeError(t *testing.T, err error, failure bool, errorType cerrors.ErrorType) {
+	t.Helper()
+	if !failure {
+		assert.NoError(t, err)
+		return
+	}
+	assert.Equal(t, errorType, cerrors.GetErrorType(err), err)
+}
+
+func TestProbeModes(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := closed.Addr().String()
+	closed.Close()
+
+	tests := []struct {
+		name    string
+		mode    string
+		address string
+		verdict v1alpha1.ProbeVerdict
+		passed  int
+	}{
+		{name: "continuous passed", mode: "Continuous", address: lis.Addr().String(), verdict: v1alpha1.ProbeVerdictPassed, passed: 1},
+		{name: "continuous failed", mode: "Continuous", address: closedAddr, verdict: v1alpha1.ProbeVerdictFailed},
+		{name: "onchaos passed", mode: "OnChaos", address: lis.Addr().String(), verdict: v1alpha1.ProbeVerdictPassed, passed: 1},
+		{name: "onchaos failed", mode: "OnChaos", address: closedAddr, verdict: v1alpha1.ProbeVerdictFailed},
+		{name: "edge passed", mode: "Edge", address: lis.Addr().String(), verdict: v1alpha1.ProbeVerdictPassed, passed: 1},
+		{name: "edge failed", mode: "Edge", address: closedAddr, verdict: v1alpha1.ProbeVerdictFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe, resultDetails := newTestProbe("check-redis", "netProbe", tt.mode, "address: "+tt.address,
+				types.ProbeTimeouts{ProbeTimeout: time.Second, ProbePollingInterval: 50 * time.Millisecond})
+			chaosDetails := &types.ChaosDetails{ChaosDuration: 1, Delay: 1, Timeout: 10}
+			chaosDetails.ProbeContext.Ctx, chaosDetails.ProbeContext.CancelFunc = context.WithCancel(context.Background())
+
+			require.NoError(t, prepareNetProbe(probe, clients.ClientSets{}, chaosDetails, resultDetails, "PreChaos"))
+			require.NoError(t, prepareNetProbe(probe, clients.ClientSets{}, chaosDetails, resultDetails, "DuringChaos"))
+			time.Sleep(200 * time.Millisecond)
+			chaosDetails.ProbeContext.CancelFunc()
+			require.NoError(t, prepareNetProbe(probe, clients.ClientSets{}, chaosDetails, resultDetails, "PostChaos"))
+
+			assert.Equal(t, tt.verdict, resultDetails.ProbeDetails[0].Status.Verdict)
+			assert.Equal(t, tt.passed, resultDetails.PassedProbeCount)
+		})
+	}
+}
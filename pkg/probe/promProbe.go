@@ -1,9 +1,15 @@
 package probe
 
 import (
-	"bytes"
+	"encoding/json"
 	"fmt"
-	"os/exec"
+	"io"
+	gomath "math"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,8 +20,11 @@ import (
 	"github.com/litmuschaos/litmus-go/pkg/math"
 	cmp "github.com/litmuschaos/litmus-go/pkg/probe/comparator"
 	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils"
+	"github.com/litmuschaos/litmus-go/pkg/utils/httpauth"
 	"github.com/litmuschaos/litmus-go/pkg/utils/retry"
 	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
 )
 
 // preparePromProbe contains the steps to prepare the prometheus probe
@@ -66,7 +75,7 @@ func preChaosPromProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.Resu
 		}
 
 		// triggering the prom probe and storing the output into the out buffer
-		if err = triggerPromProbe(probe, resultDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypePromProbe {
+		if err = triggerPromProbe(probe, resultDetails, chaosDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypePromProbe {
 			return err
 		}
 
@@ -121,7 +130,7 @@ func postChaosPromProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.Res
 		}
 
 		// triggering the prom probe and storing the output into the out buffer
-		if err = triggerPromProbe(probe, resultDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypePromProbe {
+		if err = triggerPromProbe(probe, resultDetails, chaosDetails); err != nil && cerrors.GetErrorType(err) != cerrors.FailureTypePromProbe {
 			return err
 		}
 
@@ -170,58 +179,154 @@ func onChaosPromProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.Resul
 	return nil
 }
 
-// triggerPromProbe trigger the prometheus probe inside the external pod
-func triggerPromProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails) error {
+// promProbeInputs contains the additional inputs of the prom probe
+type promProbeInputs struct {
+	// Auth contains the bearer token or the basic auth credentials
+	httpauth.Auth `json:",inline"`
+	// TLS contains the ca cert and the client cert for the https endpoints
+	TLS *httpauth.TLS `json:"tls,omitempty"`
+	// Tenant is sent inside the X-Scope-OrgID header, which is used by thanos, mimir and cortex
+	Tenant string `json:"tenant,omitempty"`
+	// Headers are sent along with every query
+	Headers map[string]string `json:"headers,omitempty"`
+	// Range evaluates the query over a window and aggregates the samples of each series, the instant query is used otherwise
+	Range *promProbeRange `json:"range,omitempty"`
+	// Match is either all or any, all the series or any of them should follow the comparator, it defaults to all
+	Match string `json:"match,omitempty"`
+}
+
+// promProbeRange contains the window of the range query and the aggregation of the samples
+type promProbeRange struct {
+	// Window is the duration of the range, which ends at the probe execution, it defaults to the chaos duration
+	Window string `json:"window,omitempty"`
+	// Step is the resolution of the range, it defaults to a hundredth of the window
+	Step string `json:"step,omitempty"`
+	// Aggregation is one of min, max, avg, sum, last or a percentile, such as p99
+	Aggregation string `json:"aggregation"`
+}
+
+// promSeries is the value of a series returned by the query
+type promSeries struct {
+	labels string
+	value  float64
+}
+
+// promResponse is the response of the prometheus query api
+type promResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// parsePromProbeInputs parses the additional inputs of the prom probe from the data field
+func parsePromProbeInputs(probe v1alpha1.ProbeAttributes) (*promProbeInputs, error) {
+	inputs := &promProbeInputs{}
+	if err := yaml.UnmarshalStrict([]byte(probe.Data), inputs); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("failed to parse the prom probe inputs: %s", err.Error())}
+	}
+
+	var reason string
+	switch err := inputs.Auth.Validate(); {
+	case err != nil:
+		reason = err.Error()
+	case inputs.Match != "" && inputs.Match != "all" && inputs.Match != "any":
+		reason = fmt.Sprintf("match '%s' not supported in the prom probe, it should be either all or any", inputs.Match)
+	case inputs.Range != nil:
+		if _, err := aggregateSamples([]float64{0}, inputs.Range.Aggregation); err != nil {
+			reason = err.Error()
+		}
+	}
+	if reason != "" {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: reason}
+	}
+	if inputs.Match == "" {
+		inputs.Match = "all"
+	}
+	return inputs, nil
+}
+
+// triggerPromProbe queries the prometheus http api and verify the value of each series to follow the specified criteria
+func triggerPromProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.ResultDetails, chaosDetails *types.ChaosDetails) error {
 	probeTimeout := getProbeTimeouts(probe.Name, resultDetails.ProbeDetails)
 
+	inputs, err := parsePromProbeInputs(probe)
+	if err != nil {
+		return err
+	}
+
+	// It will use query or queryPath to get the prometheus metrics
+	// if both are provided, it will use query
+	query := probe.PromProbeInputs.Query
+	if query == "" {
+		if probe.PromProbeInputs.QueryPath == "" {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: "[Probe]: Any one of query or queryPath is required"}
+		}
+		content, err := os.ReadFile(probe.PromProbeInputs.QueryPath)
+		if err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to read the query, err: %v", err)}
+		}
+		query = strings.TrimSpace(string(content))
+	}
+
+	client := &http.Client{Timeout: probeTimeout.ProbeTimeout}
+	if inputs.TLS != nil {
+		tlsConfig, err := inputs.TLS.Config()
+		if err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: err.Error()}
+		}
+		client.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+	}
+
 	var description string
-	// running the prom probe command and matching the output
 	// it will retry for some retry count, in each iteration of try it contains following things
 	// it contains a timeout per iteration of retry. if the timeout expires without success then it will go to next try
-	// for a timeout, it will run the command, if it fails wait for the interval and again execute the command until timeout expires
+	// for a timeout, it will run the query, if it fails wait for the interval and again run the query until timeout expires
 	if err := retry.Times(uint(getAttempts(probe.RunProperties.Attempt, probe.RunProperties.Retry))).
 		Timeout(probeTimeout.ProbeTimeout).
 		Wait(probeTimeout.Interval).
 		TryWithTimeout(func(attempt uint) error {
-			var command string
-			// It will use query or queryPath to get the prometheus metrics
-			// if both are provided, it will use query
-			if probe.PromProbeInputs.Query != "" {
-				command = "promql --host " + probe.PromProbeInputs.Endpoint + " \"" + probe.PromProbeInputs.Query + "\"" + " --output csv"
-			} else if probe.PromProbeInputs.QueryPath != "" {
-				command = "promql --host " + probe.PromProbeInputs.Endpoint + " \"$(cat " + probe.PromProbeInputs.QueryPath + ")\"" + " --output csv"
-			} else {
-				return cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: "[Probe]: Any one of query or queryPath is required"}
-			}
-
-			var out, errOut bytes.Buffer
-			// run the inline command probe
-			cmd := exec.Command("/bin/sh", "-c", command)
-			cmd.Stdout = &out
-			cmd.Stderr = &errOut
-			if err := cmd.Run(); err != nil {
-				return cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("unable to run command, error: %s", errOut.String())}
-			}
-
-			// extract the values from the metrics
-			value, err := extractValueFromMetrics(strings.TrimSpace(out.String()), probe.Name)
+			series, err := queryPrometheus(client, probe.PromProbeInputs.Endpoint, query, inputs, time.Duration(chaosDetails.ChaosDuration)*time.Second)
 			if err != nil {
-				return err
+				e, ok := err.(cerrors.Error)
+				if !ok {
+					e = cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Reason: err.Error()}
+				}
+				e.Target = fmt.Sprintf("{name: %v}", probe.Name)
+				return e
 			}
 
 			rc := getAndIncrementRunCount(resultDetails, probe.Name)
-			// comparing the metrics output with the expected criteria
-			if err = cmp.RunCount(rc).
-				FirstValue(value).
-				SecondValue(probe.PromProbeInputs.Comparator.Value).
-				Criteria(probe.PromProbeInputs.Comparator.Criteria).
-				ProbeName(probe.Name).
-				ProbeVerbosity(probe.RunProperties.Verbosity).
-				CompareFloat(cerrors.FailureTypePromProbe); err != nil {
+			// comparing the value of each series with the expected criteria
+			var values []string
+			var failed []string
+			for _, s := range series {
+				value := strconv.FormatFloat(s.value, 'f', -1, 64)
+				values = append(values, fmt.Sprintf("%s %s", s.labels, value))
+				if err = cmp.RunCount(rc).
+					FirstValue(value).
+					SecondValue(probe.PromProbeInputs.Comparator.Value).
+					Criteria(probe.PromProbeInputs.Comparator.Criteria).
+					ProbeName(probe.Name).
+					ProbeVerbosity(probe.RunProperties.Verbosity).
+					CompareFloat(cerrors.FailureTypePromProbe); err != nil {
+					failed = append(failed, fmt.Sprintf("%s: %s", s.labels, err.(cerrors.Error).Reason))
+				}
+			}
+			if (inputs.Match == "all" && len(failed) != 0) || (inputs.Match == "any" && len(failed) == len(series)) {
+				err = cerrors.Error{ErrorCode: cerrors.FailureTypePromProbe, Target: fmt.Sprintf("{name: %v}", probe.Name), Reason: fmt.Sprintf("%s of the series doesn't match the criteria, %s", inputs.Match, strings.Join(failed, "; "))}
 				log.Errorf("The %v prom probe has been Failed, err: %v", probe.Name, err)
 				return err
 			}
-			description = fmt.Sprintf("Obtained the specified prometheus metrics. Actual value: %s. Expected value: %s", value, probe.PromProbeInputs.Comparator.Value)
+
+			if len(series) == 1 {
+				description = fmt.Sprintf("Obtained the specified prometheus metrics. Actual value: %s. Expected value: %s", strconv.FormatFloat(series[0].value, 'f', -1, 64), probe.PromProbeInputs.Comparator.Value)
+			} else {
+				description = fmt.Sprintf("Obtained the specified prometheus metrics. Actual values: [%s]. Expected value: %s", strings.Join(values, ", "), probe.PromProbeInputs.Comparator.Value)
+			}
 			return nil
 		}); err != nil {
 		return checkProbeTimeoutError(probe.Name, cerrors.FailureTypePromProbe, err)
@@ -230,6 +335,229 @@ func triggerPromProbe(probe v1alpha1.ProbeAttributes, resultDetails *types.Resul
 	return nil
 }
 
+// queryPrometheus runs the instant query, or the range query over the window ending now, and returns the value of each series
+// the samples of each series are aggregated for the range query
+func queryPrometheus(client *http.Client, endpoint, query string, inputs *promProbeInputs, chaosDuration time.Duration) ([]promSeries, error) {
+	now := time.Now()
+	params := url.Values{"query": {query}, "time": {formatPromTime(now)}}
+	path := "/api/v1/query"
+	if inputs.Range != nil {
+		window := chaosDuration
+		if inputs.Range.Window != "" {
+			var err error
+			if window, err = time.ParseDuration(inputs.Range.Window); err != nil {
+				return nil, fmt.Errorf("invalid range window: %v", err)
+			}
+		}
+		step := window / 100
+		if inputs.Range.Step != "" {
+			var err error
+			if step, err = time.ParseDuration(inputs.Range.Step); err != nil {
+				return nil, fmt.Errorf("invalid range step: %v", err)
+			}
+		}
+		step = time.Duration(math.Maximum(int(step), int(time.Second)))
+		path = "/api/v1/query_range"
+		params = url.Values{"query": {query}, "start": {formatPromTime(now.Add(-window))}, "end": {formatPromTime(now)}, "step": {strconv.FormatFloat(step.Seconds(), 'f', -1, 64)}}
+	}
+
+	// the query is sent inside the body, so that the long queries don't exceed the url limits
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(endpoint, "/")+path, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if err := setPromHeaders(req, inputs); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// the connection errors are the failures, so that they can be handled with stopOnFailure config
+		if utils.HttpTimeout(err) || utils.IsConnectionError(err) {
+			return nil, cerrors.Error{ErrorCode: cerrors.FailureTypePromProbe, Reason: err.Error()}
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var response promResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 10<<20)).Decode(&response); err != nil || response.Status != "success" {
+		reason := fmt.Sprintf("%s returned %s", req.URL.Redacted(), resp.Status)
+		if response.Error != "" {
+			reason = fmt.Sprintf("%s: %s: %s", reason, response.ErrorType, response.Error)
+		}
+		// the unavailable prometheus is a failure, the invalid queries are the errors
+		if resp.StatusCode >= 500 {
+			return nil, cerrors.Error{ErrorCode: cerrors.FailureTypePromProbe, Reason: reason}
+		}
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Reason: reason}
+	}
+
+	var aggregation string
+	if inputs.Range != nil {
+		aggregation = inputs.Range.Aggregation
+	}
+	series, err := parsePromResult(response.Data.ResultType, response.Data.Result, aggregation)
+	if err != nil {
+		return nil, err
+	}
+	if len(series) == 0 {
+		return nil, fmt.Errorf("metrics doesn't contains required values, the query returned no series")
+	}
+	return series, nil
+}
+
+// setPromHeaders sets the auth, the tenant and the custom headers of the query
+func setPromHeaders(req *http.Request, inputs *promProbeInputs) error {
+	for name, value := range inputs.Headers {
+		req.Header.Set(name, value)
+	}
+	if inputs.Tenant != "" {
+		req.Header.Set("X-Scope-OrgID", inputs.Tenant)
+	}
+	return inputs.Auth.Set(req)
+}
+
+// parsePromResult returns the value of each series of the vector, the matrix or the scalar result
+// the samples of each series of the matrix are aggregated with the given aggregation
+func parsePromResult(resultType string, result json.RawMessage, aggregation string) ([]promSeries, error) {
+	var series []promSeries
+	switch resultType {
+	case "scalar":
+		var sample [2]interface{}
+		if err := json.Unmarshal(result, &sample); err != nil {
+			return nil, err
+		}
+		value, err := parsePromSample(sample)
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, promSeries{labels: "{}", value: value})
+	case "vector":
+		var vector []struct {
+			Metric map[string]string `json:"metric"`
+			Value  [2]interface{}    `json:"value"`
+		}
+		if err := json.Unmarshal(result, &vector); err != nil {
+			return nil, err
+		}
+		for _, v := range vector {
+			value, err := parsePromSample(v.Value)
+			if err != nil {
+				return nil, err
+			}
+			series = append(series, promSeries{labels: formatPromLabels(v.Metric), value: value})
+		}
+	case "matrix":
+		if aggregation == "" {
+			return nil, fmt.Errorf("the query returned a range vector, the range with an aggregation is required")
+		}
+		var matrix []struct {
+			Metric map[string]string `json:"metric"`
+			Values [][2]interface{}  `json:"values"`
+		}
+		if err := json.Unmarshal(result, &matrix); err != nil {
+			return nil, err
+		}
+		for _, m := range matrix {
+			var samples []float64
+			for _, sample := range m.Values {
+				value, err := parsePromSample(sample)
+				if err != nil {
+					return nil, err
+				}
+				samples = append(samples, value)
+			}
+			value, err := aggregateSamples(samples, aggregation)
+			if err != nil {
+				return nil, err
+			}
+			series = append(series, promSeries{labels: formatPromLabels(m.Metric), value: value})
+		}
+	default:
+		return nil, fmt.Errorf("result type '%s' not supported in the prom probe", resultType)
+	}
+	return series, nil
+}
+
+// parsePromSample parses the value of the [timestamp, "value"] sample
+func parsePromSample(sample [2]interface{}) (float64, error) {
+	value, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid sample %v", sample)
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// aggregateSamples aggregates the samples of a series with min, max, avg, sum, last or a percentile, such as p99
+// the NaN samples are skipped, the percentiles are interpolated as in the quantile_over_time
+func aggregateSamples(samples []float64, aggregation string) (float64, error) {
+	var values []float64
+	for _, v := range samples {
+		if !gomath.IsNaN(v) {
+			values = append(values, v)
+		}
+	}
+
+	var percentile float64
+	switch aggregation {
+	case "min", "max", "avg", "sum", "last":
+	default:
+		p, err := strconv.ParseFloat(strings.TrimPrefix(aggregation, "p"), 64)
+		if !strings.HasPrefix(aggregation, "p") || err != nil || p < 0 || p > 100 {
+			return 0, fmt.Errorf("aggregation '%s' not supported in the prom probe, it should be one of min, max, avg, sum, last or a percentile such as p99", aggregation)
+		}
+		percentile = p / 100
+	}
+	if len(values) == 0 {
+		return gomath.NaN(), nil
+	}
+
+	switch aggregation {
+	case "min":
+		sort.Float64s(values)
+		return values[0], nil
+	case "max":
+		sort.Float64s(values)
+		return values[len(values)-1], nil
+	case "last":
+		return values[len(values)-1], nil
+	case "sum", "avg":
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		if aggregation == "avg" {
+			return sum / float64(len(values)), nil
+		}
+		return sum, nil
+	}
+
+	sort.Float64s(values)
+	rank := percentile * float64(len(values)-1)
+	lower := int(gomath.Floor(rank))
+	upper := int(gomath.Ceil(rank))
+	return values[lower] + (values[upper]-values[lower])*(rank-float64(lower)), nil
+}
+
+// formatPromLabels formats the labels of the series, such as up{instance="a", job="b"}
+func formatPromLabels(metric map[string]string) string {
+	var labels []string
+	for name, value := range metric {
+		if name != "__name__" {
+			labels = append(labels, fmt.Sprintf("%s=%q", name, value))
+		}
+	}
+	sort.Strings(labels)
+	return metric["__name__"] + "{" + strings.Join(labels, ", ") + "}"
+}
+
+// formatPromTime formats the time as the unix timestamp with the milliseconds
+func formatPromTime(t time.Time) string {
+	return strconv.FormatFloat(float64(t.UnixMilli())/1000, 'f', -1, 64)
+}
+
 // triggerContinuousPromProbe trigger the continuous prometheus probe
 func triggerContinuousPromProbe(probe v1alpha1.ProbeAttributes, clients clients.ClientSets, chaosresult *types.ResultDetails, chaosDetails *types.ChaosDetails) {
 	probeTimeout := getProbeTimeouts(probe.Name, chaosresult.ProbeDetails)
@@ -255,7 +583,7 @@ loop:
 			}
 			break loop
 		default:
-			err = triggerPromProbe(probe, chaosresult)
+			err = triggerPromProbe(probe, chaosresult, chaosDetails)
 			// record the error inside the probeDetails, we are maintaining a dedicated variable for the err, inside probeDetails
 			if err != nil {
 				err = addProbePhase(err, string(chaosDetails.Phase))
@@ -274,7 +602,7 @@ loop:
 			time.Sleep(probeTimeout.ProbePollingInterval)
 		}
 	}
-	// if experiment fails and stopOnfailure is provided as true then it will patch the chaosengine for abort
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
 	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
 	// and failed the experiment in the end
 	if isExperimentFailed && probe.RunProperties.StopOnFailure {
@@ -315,7 +643,7 @@ loop:
 			break loop
 		default:
 			// record the error inside the probeDetails, we are maintaining a dedicated variable for the err, inside probeDetails
-			if err = triggerPromProbe(probe, chaosresult); err != nil {
+			if err = triggerPromProbe(probe, chaosresult, chaosDetails); err != nil {
 				err = addProbePhase(err, string(chaosDetails.Phase))
 				for index := range chaosresult.ProbeDetails {
 					if chaosresult.ProbeDetails[index].Name == probe.Name {
@@ -344,7 +672,7 @@ loop:
 			}
 		}
 	}
-	// if experiment fails and stopOnfailure is provided as true then it will patch the chaosengine for abort
+	// if experiment fails and stopOnfailure is provided as true then it will abort the experiment and revert the chaos
 	// if experiment fails but stopOnfailure is provided as false then it will continue the execution
 	// and failed the experiment in the end
 	if isExperimentFailed && probe.RunProperties.StopOnFailure {
@@ -353,38 +681,3 @@ loop:
 		}
 	}
 }
-
-// extractValueFromMetrics extract the value field from the prometheus metrix
-func extractValueFromMetrics(metrics, probeName string) (string, error) {
-
-	// spliting the metrics based on newline as metrics may have multiple entries
-	rows := strings.Split(metrics, "\n")
-
-	// output should contains exact one metrics entry along with header
-	// erroring out the cases where it contains more or less entries
-	if len(rows) > 2 {
-		return "", cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Target: fmt.Sprintf("{name: %v}", probeName), Reason: "metrics entries can't be more than two"}
-	} else if len(rows) < 2 {
-		return "", cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Target: fmt.Sprintf("{name: %v}", probeName), Reason: "metrics doesn't contains required values"}
-	}
-
-	// deriving the index for the value column from the headers
-	headerColumn := strings.Split(rows[0], ",")
-	indexForValueColumn := -1
-	for index := range headerColumn {
-		if strings.ToLower(headerColumn[index]) == "value" {
-			indexForValueColumn = index
-			break
-		}
-	}
-	if indexForValueColumn == -1 {
-		return "", cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Target: fmt.Sprintf("{name: %v}", probeName), Reason: "metrics entries doesn't contains value column"}
-	}
-
-	// splitting the metrics entries which are available as comma separated
-	values := strings.Split(rows[1], ",")
-	if values[indexForValueColumn] == "" {
-		return "", cerrors.Error{ErrorCode: cerrors.ErrorTypePromProbe, Target: fmt.Sprintf("{name: %v}", probeName), Reason: "error while parsing value from derived matrics"}
-	}
-	return values[indexForValueColumn], nil
-}
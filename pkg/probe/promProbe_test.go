@@ -0,0 +1,92 @@
+package probe
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+func TestTriggerPromProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("X-Scope-OrgID") != "team-a" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/query":
+			if r.FormValue("query") != `sum by (pod) (rate(http_requests_total{code="500"}[1m]))` {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]string{"status": "error", "errorType": "bad_data", "error": "unexpected query"})
+				return
+			}
+			w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[
+				{"metric":{"pod":"orders-1"},"value":[1700000000,"0.5"]},
+				{"metric":{"pod":"orders-2"},"value":[1700000000,"3"]}]}}`))
+		case "/api/v1/query_range":
+			w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[
+				{"metric":{"__name__":"latency"},"values":[[1700000000,"1"],[1700000015,"4"],[1700000030,"NaN"],[1700000045,"2"]]}]}}`))
+		}
+	}))
+	defer server.Close()
+
+	query := `sum by (pod) (rate(http_requests_total{code="500"}[1m]))`
+	auth := "bearerToken: secret\ntenant: team-a\n"
+	tests := []struct {
+		name       string
+		query      string
+		data       string
+		comparator v1alpha1.ComparatorInfo
+		errorType  cerrors.ErrorType
+	}{
+		{name: "all series match", query: query, data: auth, comparator: v1alpha1.ComparatorInfo{Criteria: "<", Value: "5"}},
+		{name: "one of the series doesn't match", query: query, data: auth, comparator: v1alpha1.ComparatorInfo{Criteria: "<", Value: "1"}, errorType: cerrors.FailureTypePromProbe},
+		{name: "any of the series match", query: query, data: auth + "match: any", comparator: v1alpha1.ComparatorInfo{Criteria: "<", Value: "1"}},
+		{name: "range aggregation", query: "latency", data: auth + "range:\n  window: 5m\n  aggregation: max", comparator: v1alpha1.ComparatorInfo{Criteria: "==", Value: "4"}},
+		{name: "range percentile", query: "latency", data: auth + "range:\n  aggregation: p50", comparator: v1alpha1.ComparatorInfo{Criteria: "==", Value: "2"}},
+		{name: "unauthorized", query: query, data: "tenant: team-a", comparator: v1alpha1.ComparatorInfo{Criteria: "<", Value: "5"}, errorType: cerrors.ErrorTypePromProbe},
+		{name: "invalid query", query: "up", data: auth, comparator: v1alpha1.ComparatorInfo{Criteria: "<", Value: "5"}, errorType: cerrors.ErrorTypePromProbe},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe, resultDetails := newTestProbe("check-errors", "promProbe", "EOT", tt.data, types.ProbeTimeouts{ProbeTimeout: 5 * time.Second})
+			probe.PromProbeInputs = &v1alpha1.PromProbeInputs{Endpoint: server.URL, Query: tt.query, Comparator: tt.comparator}
+			err := triggerPromProbe(probe, resultDetails, &types.ChaosDetails{ChaosDuration: 60})
+			assertProbeError(t, err, tt.errorType != "", tt.errorType)
+		})
+	}
+}
+
+func TestAggregateSamples(t *testing.T) {
+	samples := []float64{4, 1, 3, 2}
+	for aggregation, want := range map[string]float64{"min": 1, "max": 4, "avg": 2.5, "sum": 10, "last": 2, "p50": 2.5, "p100": 4, "p0": 1} {
+		value, err := aggregateSamples(samples, aggregation)
+		require.NoError(t, err, aggregation)
+		assert.Equal(t, want, value, aggregation)
+	}
+
+	for _, aggregation := range []string{"", "median", "p101", "px"} {
+		_, err := aggregateSamples(samples, aggregation)
+		assert.Error(t, err, aggregation)
+	}
+}
+
+func TestParsePromProbeInputs(t *testing.T) {
+	inputs, err := parsePromProbeInputs(v1alpha1.ProbeAttributes{})
+	require.NoError(t, err)
+	assert.Equal(t, "all", inputs.Match)
+
+	for _, data := range []string{"match: some", "range:\n  aggregation: median", "bearerToken: a\nbasicAuth:\n  username: b", "timeout: 5"} {
+		_, err := parsePromProbeInputs(v1alpha1.ProbeAttributes{Data: data})
+		assert.Error(t, err, data)
+	}
+}
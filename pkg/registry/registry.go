@@ -0,0 +1,92 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+)
+
+// Runner is the entrypoint of an experiment or a helper
+type Runner func(ctx context.Context, clients clients.ClientSets)
+
+// Entry contains the details of a registered experiment or helper
+type Entry struct {
+	Name        string
+	Description string
+	Run         Runner
+}
+
+// Registry is a named collection of entries, safe for concurrent use
+type Registry struct {
+	mu      sync.RWMutex
+	entries map[string]Entry
+}
+
+var (
+	// Experiments contains all the experiments available to the experiment binary
+	Experiments = New()
+	// Helpers contains all the helpers available to the helper binary
+	Helpers = New()
+)
+
+// New returns an empty registry
+func New() *Registry {
+	return &Registry{entries: map[string]Entry{}}
+}
+
+// Register adds the given entry to the registry
+// it panics if the entry is incomplete or the name is already registered,
+// as both are programming errors which should be caught at startup
+func (r *Registry) Register(entry Entry) {
+	if entry.Name == "" {
+		panic("registry: entry name can't be empty")
+	}
+	if entry.Run == nil {
+		panic(fmt.Sprintf("registry: entrypoint of %v can't be nil", entry.Name))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.entries[entry.Name]; ok {
+		panic(fmt.Sprintf("registry: %v is already registered", entry.Name))
+	}
+	r.entries[entry.Name] = entry
+}
+
+// Get returns the entry registered with the given name
+func (r *Registry) Get(name string) (Entry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entry, ok := r.entries[name]
+	return entry, ok
+}
+
+// List returns all the registered entries, sorted by name
+func (r *Registry) List() []Entry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]Entry, 0, len(r.entries))
+	for _, entry := range r.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
+
+// RegisterExperiment registers an experiment entrypoint with the given name
+func RegisterExperiment(name, description string, run Runner) {
+	Experiments.Register(Entry{Name: name, Description: description, Run: run})
+}
+
+// RegisterHelper registers a helper entrypoint with the given name
+func RegisterHelper(name, description string, run Runner) {
+	Helpers.Register(Entry{Name: name, Description: description, Run: run})
+}
@@ -0,0 +1,57 @@
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/stretchr/testify/assert"
+)
+
+func noop(ctx context.Context, clients clients.ClientSets) {}
+
+func TestRegistry(t *testing.T) {
+	r := New()
+	r.Register(Entry{Name: "pod-delete", Description: "deletes pods", Run: noop})
+	r.Register(Entry{Name: "container-kill", Description: "kills containers", Run: noop})
+
+	entry, ok := r.Get("pod-delete")
+	assert.True(t, ok)
+	assert.Equal(t, "deletes pods", entry.Description)
+
+	_, ok = r.Get("unknown")
+	assert.False(t, ok)
+
+	var names []string
+	for _, entry := range r.List() {
+		names = append(names, entry.Name)
+	}
+	assert.Equal(t, []string{"container-kill", "pod-delete"}, names)
+}
+
+func TestRegisterInvalidEntry(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry Entry
+	}{
+		{
+			name:  "empty name",
+			entry: Entry{Run: noop},
+		},
+		{
+			name:  "nil entrypoint",
+			entry: Entry{Name: "pod-delete"},
+		},
+		{
+			name:  "duplicate name",
+			entry: Entry{Name: "pod-delete", Run: noop},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New()
+			r.Register(Entry{Name: "pod-delete", Run: noop})
+			assert.Panics(t, func() { r.Register(tt.entry) })
+		})
+	}
+}
@@ -0,0 +1,253 @@
+// Package report writes the machine-readable summary of the run, once it completes or gets aborted
+//
+// REPORT_FILE is the local file, which contains the report in the REPORT_FORMAT, i.e, json (default) or junit
+// REPORT_CONFIGMAP is the configmap inside the chaos namespace, which contains the report in both the formats,
+// under the report.json and junit.xml keys. The service account of the experiment requires
+// the create, get and update verbs on the configmaps to write it, as provided by the rbac.yaml of the experiments
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// the supported report formats
+const (
+	FormatJSON  = "json"
+	FormatJUnit = "junit"
+)
+
+// the keys of the report configmap, it contains the report in all the formats
+const (
+	JSONKey  = "report.json"
+	JUnitKey = "junit.xml"
+)
+
+// Report is the machine-readable summary of an experiment run, derived from the chaosresult details
+type Report struct {
+	Experiment string                   `json:"experiment"`
+	Engine     string                   `json:"engine,omitempty"`
+	Namespace  string                   `json:"namespace"`
+	InstanceID string                   `json:"instanceID,omitempty"`
+	Result     string                   `json:"result"`
+	Verdict    string                   `json:"verdict"`
+	Phase      string                   `json:"phase"`
+	FailStep   string                   `json:"failStep,omitempty"`
+	ErrorCode  string                   `json:"errorCode,omitempty"`
+	DryRun     bool                     `json:"dryRun,omitempty"`
+	StartTime  time.Time                `json:"startTime"`
+	EndTime    time.Time                `json:"endTime"`
+	Probes     []Probe                  `json:"probes,omitempty"`
+	Targets    []v1alpha1.TargetDetails `json:"targets,omitempty"`
+}
+
+// Probe contains the verdict of a probe
+type Probe struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Mode        string `json:"mode"`
+	Verdict     string `json:"verdict"`
+	Description string `json:"description,omitempty"`
+}
+
+// New builds the report of the run, which started at the given time
+func New(chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails, startTime time.Time) *Report {
+	report := &Report{
+		Experiment: chaosDetails.ExperimentName,
+		Engine:     chaosDetails.EngineName,
+		Namespace:  chaosDetails.ChaosNamespace,
+		InstanceID: chaosDetails.InstanceID,
+		Result:     resultDetails.Name,
+		Verdict:    string(resultDetails.Verdict),
+		Phase:      string(resultDetails.Phase),
+		DryRun:     chaosDetails.DryRun,
+		StartTime:  startTime.UTC(),
+		EndTime:    time.Now().UTC(),
+		Targets:    chaosDetails.Targets,
+	}
+	if resultDetails.ErrorOutput != nil {
+		report.FailStep = resultDetails.ErrorOutput.Reason
+		report.ErrorCode = resultDetails.ErrorOutput.ErrorCode
+	}
+	for _, probe := range resultDetails.ProbeDetails {
+		report.Probes = append(report.Probes, Probe{
+			Name:        probe.Name,
+			Type:        probe.Type,
+			Mode:        probe.Mode,
+			Verdict:     string(probe.Status.Verdict),
+			Description: probe.Status.Description,
+		})
+	}
+	return report
+}
+
+// JSON returns the report in the json format
+func (report *Report) JSON() ([]byte, error) {
+	return json.MarshalIndent(report, "", "  ")
+}
+
+// Format returns the report in the given format
+func (report *Report) Format(format string) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return report.JSON()
+	case FormatJUnit:
+		return report.JUnit()
+	default:
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("unsupported report format %q, supported formats are %s and %s", format, FormatJSON, FormatJUnit)}
+	}
+}
+
+// Write writes the report of the run inside the report file and the report configmap, if provided
+func Write(chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails, clients clients.ClientSets, startTime time.Time) error {
+	if chaosDetails.ReportFile == "" && chaosDetails.ReportConfigMap == "" {
+		return nil
+	}
+	report := New(chaosDetails, resultDetails, startTime)
+
+	if chaosDetails.ReportFile != "" {
+		data, err := report.Format(chaosDetails.ReportFormat)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(chaosDetails.ReportFile, data, 0644); err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{file: %s}", chaosDetails.ReportFile), Reason: fmt.Sprintf("failed to write the report: %s", err.Error())}
+		}
+	}
+
+	if chaosDetails.ReportConfigMap != "" {
+		jsonReport, err := report.JSON()
+		if err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("failed to marshal the report: %s", err.Error())}
+		}
+		junitReport, err := report.JUnit()
+		if err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("failed to marshal the report: %s", err.Error())}
+		}
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      chaosDetails.ReportConfigMap,
+				Namespace: chaosDetails.ChaosNamespace,
+				Labels:    map[string]string{"chaosUID": string(chaosDetails.ChaosUID)},
+			},
+			Data: map[string]string{
+				JSONKey:  string(jsonReport),
+				JUnitKey: string(junitReport),
+			},
+		}
+		if err := clients.ApplyConfigMap(chaosDetails.ChaosNamespace, configMap); err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{name: %s, namespace: %s}", chaosDetails.ReportConfigMap, chaosDetails.ChaosNamespace), Reason: fmt.Sprintf("failed to write the report: %s", err.Error())}
+		}
+	}
+	return nil
+}
+
+// the junit xml schema, as consumed by the CI pipelines
+type (
+	testSuites struct {
+		XMLName xml.Name    `xml:"testsuites"`
+		Suites  []testSuite `xml:"testsuite"`
+	}
+
+	testSuite struct {
+		Name       string     `xml:"name,attr"`
+		Tests      int        `xml:"tests,attr"`
+		Failures   int        `xml:"failures,attr"`
+		Errors     int        `xml:"errors,attr"`
+		Skipped    int        `xml:"skipped,attr"`
+		Time       string     `xml:"time,attr"`
+		Timestamp  string     `xml:"timestamp,attr"`
+		Properties []property `xml:"properties>property"`
+		TestCases  []testCase `xml:"testcase"`
+	}
+
+	property struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"value,attr"`
+	}
+
+	testCase struct {
+		Name      string   `xml:"name,attr"`
+		ClassName string   `xml:"classname,attr"`
+		Failure   *message `xml:"failure,omitempty"`
+		Error     *message `xml:"error,omitempty"`
+		Skipped   *message `xml:"skipped,omitempty"`
+	}
+
+	message struct {
+		Message string `xml:"message,attr,omitempty"`
+		Type    string `xml:"type,attr,omitempty"`
+	}
+)
+
+// JUnit returns the report in the junit xml format
+// the experiment verdict is the first testcase, followed by a testcase per probe
+func (report *Report) JUnit() ([]byte, error) {
+	suite := testSuite{
+		Name:      report.Experiment,
+		Time:      fmt.Sprintf("%.3f", report.EndTime.Sub(report.StartTime).Seconds()),
+		Timestamp: report.StartTime.Format(time.RFC3339),
+		Properties: []property{
+			{Name: "engine", Value: report.Engine},
+			{Name: "namespace", Value: report.Namespace},
+			{Name: "result", Value: report.Result},
+			{Name: "verdict", Value: report.Verdict},
+			{Name: "phase", Value: report.Phase},
+		},
+	}
+	for _, target := range report.Targets {
+		suite.Properties = append(suite.Properties, property{Name: "target", Value: fmt.Sprintf("%s/%s: %s", target.Kind, target.Name, target.ChaosStatus)})
+	}
+
+	experiment := testCase{Name: report.Experiment, ClassName: report.Experiment}
+	switch v1alpha1.ResultVerdict(report.Verdict) {
+	case v1alpha1.ResultVerdictPassed:
+	case v1alpha1.ResultVerdictFailed:
+		experiment.Failure = &message{Message: report.FailStep, Type: report.Verdict}
+	case v1alpha1.ResultVerdictStopped:
+		experiment.Skipped = &message{Message: report.FailStep}
+	default:
+		experiment.Error = &message{Message: report.FailStep, Type: report.ErrorCode}
+	}
+	suite.TestCases = append(suite.TestCases, experiment)
+
+	for _, probe := range report.Probes {
+		probeCase := testCase{Name: probe.Name, ClassName: report.Experiment + "." + probe.Type}
+		switch v1alpha1.ProbeVerdict(probe.Verdict) {
+		case v1alpha1.ProbeVerdictPassed:
+		case v1alpha1.ProbeVerdictFailed:
+			probeCase.Failure = &message{Message: probe.Description, Type: probe.Mode}
+		default:
+			probeCase.Skipped = &message{Message: probe.Description}
+		}
+		suite.TestCases = append(suite.TestCases, probeCase)
+	}
+
+	for _, tc := range suite.TestCases {
+		suite.Tests++
+		switch {
+		case tc.Failure != nil:
+			suite.Failures++
+		case tc.Error != nil:
+			suite.Errors++
+		case tc.Skipped != nil:
+			suite.Skipped++
+		}
+	}
+
+	data, err := xml.MarshalIndent(testSuites{Suites: []testSuite{suite}}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
@@ -0,0 +1,66 @@
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newReport() *Report {
+	chaosDetails := &types.ChaosDetails{
+		ExperimentName: "pod-delete",
+		EngineName:     "nginx-chaos",
+		ChaosNamespace: "litmus",
+		Targets:        []v1alpha1.TargetDetails{{Name: "nginx-7f8d9", Kind: "pod", ChaosStatus: "injected"}},
+	}
+	resultDetails := &types.ResultDetails{
+		Name:    "nginx-chaos-pod-delete",
+		Verdict: v1alpha1.ResultVerdictFailed,
+		Phase:   v1alpha1.ResultPhaseCompleted,
+		ProbeDetails: []*types.ProbeDetails{
+			{Name: "check-frontend", Type: "httpProbe", Mode: "Continuous", Status: v1alpha1.ProbeStatus{Verdict: v1alpha1.ProbeVerdictPassed}},
+			{Name: "check-replicas", Type: "k8sProbe", Mode: "EOT", Status: v1alpha1.ProbeStatus{Verdict: v1alpha1.ProbeVerdictFailed, Description: "resource is not found"}},
+			{Name: "check-latency", Type: "promProbe", Mode: "OnChaos", Status: v1alpha1.ProbeStatus{Verdict: v1alpha1.ProbeVerdictNA}},
+		},
+	}
+	return New(chaosDetails, resultDetails, time.Now().Add(-time.Minute))
+}
+
+func TestJSON(t *testing.T) {
+	data, err := newReport().Format(FormatJSON)
+	require.NoError(t, err)
+
+	var report Report
+	require.NoError(t, json.Unmarshal(data, &report))
+	assert.Equal(t, "pod-delete", report.Experiment)
+	assert.Equal(t, "Fail", report.Verdict)
+	assert.Len(t, report.Probes, 3)
+	assert.Equal(t, "resource is not found", report.Probes[1].Description)
+	assert.Len(t, report.Targets, 1)
+}
+
+func TestJUnit(t *testing.T) {
+	data, err := newReport().Format(FormatJUnit)
+	require.NoError(t, err)
+
+	var suites testSuites
+	require.NoError(t, xml.Unmarshal(data, &suites))
+	require.Len(t, suites.Suites, 1)
+	suite := suites.Suites[0]
+	assert.Equal(t, 4, suite.Tests)
+	assert.Equal(t, 2, suite.Failures)
+	assert.Equal(t, 0, suite.Errors)
+	assert.Equal(t, 1, suite.Skipped)
+	assert.Equal(t, "check-replicas", suite.TestCases[2].Name)
+	require.NotNil(t, suite.TestCases[2].Failure)
+	assert.Equal(t, "resource is not found", suite.TestCases[2].Failure.Message)
+
+	_, err = newReport().Format("yaml")
+	assert.Error(t, err)
+}
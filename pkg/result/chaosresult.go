@@ -3,7 +3,9 @@ package result
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"os/exec"
 	"strconv"
 	"strings"
@@ -18,14 +20,31 @@ import (
 	"github.com/litmuschaos/litmus-go/pkg/events"
 	"github.com/litmuschaos/litmus-go/pkg/log"
 	"github.com/litmuschaos/litmus-go/pkg/probe"
+	"github.com/litmuschaos/litmus-go/pkg/telemetry"
 	"github.com/litmuschaos/litmus-go/pkg/types"
 	"github.com/litmuschaos/litmus-go/pkg/utils/retry"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// StandaloneEnv marks the helper pods of the standalone mode, where the chaosresult doesn't exist
+const StandaloneEnv = "STANDALONE"
+
+// PlanAnnotation is the chaosresult annotation, which contains the plan of the experiment in dry-run mode
+const PlanAnnotation = "litmuschaos.io/dry-run-plan"
+
+// FaultsAnnotation is the chaosresult annotation, which contains the statuses of the faults of the composite experiment
+const FaultsAnnotation = "litmuschaos.io/fault-status"
+
+// RampAnnotation is the chaosresult annotation, which contains the highest intensity survived in the ramp mode
+const RampAnnotation = "litmuschaos.io/ramp"
+
 // ChaosResult Create and Update the chaos result
 func ChaosResult(chaosDetails *types.ChaosDetails, clients clients.ClientSets, resultDetails *types.ResultDetails, state string) error {
+	if chaosDetails.ResultFile != "" {
+		return writeResultFile(chaosDetails, resultDetails, state)
+	}
+
 	experimentLabel := map[string]string{}
 
 	// It tries to get the chaosresult, if available
@@ -71,8 +90,34 @@ func ChaosResult(chaosDetails *types.ChaosDetails, clients clients.ClientSets, r
 // InitializeChaosResult create the chaos result
 func InitializeChaosResult(chaosDetails *types.ChaosDetails, clients clients.ClientSets, resultDetails *types.ResultDetails, chaosResultLabel map[string]string) error {
 
+	chaosResult := newChaosResult(chaosDetails, resultDetails, chaosResultLabel)
+
+	// It will create a new chaos-result CR
+	_, err := clients.LitmusClient.ChaosResults(chaosDetails.ChaosNamespace).Create(context.Background(), chaosResult, v1.CreateOptions{})
+
+	// if the chaos result is already present, it will patch the new parameters with the existing chaos result CR
+	// Note: We have added labels inside chaos result and looking for matching labels to list the chaos-result
+	// these labels were not present inside earlier releases so giving a retry/update if someone has an exiting result CR
+	// in his cluster, which was created earlier with older release/version of litmus.
+	// it will override the params and add the labels to it so that it will work as desired.
+	if k8serrors.IsAlreadyExists(err) {
+		_, err = clients.LitmusClient.ChaosResults(chaosDetails.ChaosNamespace).Get(context.Background(), resultDetails.Name, v1.GetOptions{})
+		if err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosResultCRUD, Target: fmt.Sprintf("{name: %s, namespace: %s}", resultDetails.Name, chaosDetails.ChaosNamespace), Reason: err.Error()}
+		}
+
+		// updating the chaosresult with new values
+		if err = PatchChaosResult(clients, chaosDetails, resultDetails, chaosResultLabel); err != nil {
+			return stacktrace.Propagate(err, "could not update chaos result")
+		}
+	}
+	return nil
+}
+
+// newChaosResult returns a new chaosresult, initialised with the current status of the experiment
+func newChaosResult(chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails, chaosResultLabel map[string]string) *v1alpha1.ChaosResult {
 	_, _, probeStatus := GetProbeStatus(resultDetails)
-	chaosResult := &v1alpha1.ChaosResult{
+	return &v1alpha1.ChaosResult{
 		ObjectMeta: v1.ObjectMeta{
 			Name:      resultDetails.Name,
 			Namespace: chaosDetails.ChaosNamespace,
@@ -98,27 +143,6 @@ func InitializeChaosResult(chaosDetails *types.ChaosDetails, clients clients.Cli
 			},
 		},
 	}
-
-	// It will create a new chaos-result CR
-	_, err := clients.LitmusClient.ChaosResults(chaosDetails.ChaosNamespace).Create(context.Background(), chaosResult, v1.CreateOptions{})
-
-	// if the chaos result is already present, it will patch the new parameters with the existing chaos result CR
-	// Note: We have added labels inside chaos result and looking for matching labels to list the chaos-result
-	// these labels were not present inside earlier releases so giving a retry/update if someone has an exiting result CR
-	// in his cluster, which was created earlier with older release/version of litmus.
-	// it will override the params and add the labels to it so that it will work as desired.
-	if k8serrors.IsAlreadyExists(err) {
-		_, err = clients.LitmusClient.ChaosResults(chaosDetails.ChaosNamespace).Get(context.Background(), resultDetails.Name, v1.GetOptions{})
-		if err != nil {
-			return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosResultCRUD, Target: fmt.Sprintf("{name: %s, namespace: %s}", resultDetails.Name, chaosDetails.ChaosNamespace), Reason: err.Error()}
-		}
-
-		// updating the chaosresult with new values
-		if err = PatchChaosResult(clients, chaosDetails, resultDetails, chaosResultLabel); err != nil {
-			return stacktrace.Propagate(err, "could not update chaos result")
-		}
-	}
-	return nil
 }
 
 // GetProbeStatus fetch status of all probes
@@ -149,7 +173,14 @@ func updateResultAttributes(clients clients.ClientSets, chaosDetails *types.Chao
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "could not get chaos status")
 	}
+	if err := setResultAttributes(result, chaosDetails, resultDetails, chaosResultLabel); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
+// setResultAttributes sets the current status of the experiment inside the given chaosresult
+func setResultAttributes(result *v1alpha1.ChaosResult, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails, chaosResultLabel map[string]string) error {
 	updateHistory(result)
 	var isAllProbePassed, experimentStopped bool
 	result.Status.ExperimentStatus.Phase = resultDetails.Phase
@@ -160,6 +191,42 @@ func updateResultAttributes(clients clients.ClientSets, chaosDetails *types.Chao
 
 	// for existing chaos result resource it will patch the label
 	result.ObjectMeta.Labels = chaosResultLabel
+
+	// record the plan of the experiment, derived in dry-run mode
+	if len(chaosDetails.Plan) != 0 {
+		plan, err := json.Marshal(chaosDetails.Plan)
+		if err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosResultCRUD, Target: fmt.Sprintf("{name: %s, namespace: %s}", resultDetails.Name, chaosDetails.ChaosNamespace), Reason: fmt.Sprintf("failed to marshal the plan: %s", err.Error())}
+		}
+		if result.ObjectMeta.Annotations == nil {
+			result.ObjectMeta.Annotations = map[string]string{}
+		}
+		result.ObjectMeta.Annotations[PlanAnnotation] = string(plan)
+	}
+
+	// record the statuses of the faults of the composite experiment
+	if len(chaosDetails.Faults) != 0 {
+		faults, err := json.Marshal(chaosDetails.Faults)
+		if err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosResultCRUD, Target: fmt.Sprintf("{name: %s, namespace: %s}", resultDetails.Name, chaosDetails.ChaosNamespace), Reason: fmt.Sprintf("failed to marshal the fault statuses: %s", err.Error())}
+		}
+		if result.ObjectMeta.Annotations == nil {
+			result.ObjectMeta.Annotations = map[string]string{}
+		}
+		result.ObjectMeta.Annotations[FaultsAnnotation] = string(faults)
+	}
+
+	// record the highest intensity survived in the ramp mode
+	if chaosDetails.Ramp != nil {
+		ramp, err := json.Marshal(chaosDetails.Ramp)
+		if err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosResultCRUD, Target: fmt.Sprintf("{name: %s, namespace: %s}", resultDetails.Name, chaosDetails.ChaosNamespace), Reason: fmt.Sprintf("failed to marshal the ramp status: %s", err.Error())}
+		}
+		if result.ObjectMeta.Annotations == nil {
+			result.ObjectMeta.Annotations = map[string]string{}
+		}
+		result.ObjectMeta.Annotations[RampAnnotation] = string(ramp)
+	}
 	result.Status.History.Targets = chaosDetails.Targets
 	isAllProbePassed, experimentStopped, result.Status.ProbeStatuses = GetProbeStatus(resultDetails)
 	result.Status.ExperimentStatus.Verdict = resultDetails.Verdict
@@ -201,7 +268,7 @@ func updateResultAttributes(clients clients.ClientSets, chaosDetails *types.Chao
 	default:
 		result.Status.ExperimentStatus.ProbeSuccessPercentage = "Awaited"
 	}
-	return result, nil
+	return nil
 }
 
 // PatchChaosResult Update the chaos result
@@ -234,6 +301,11 @@ func PatchChaosResult(clients clients.ClientSets, chaosDetails *types.ChaosDetai
 
 // SetResultUID sets the ResultUID into the ResultDetails structure
 func SetResultUID(resultDetails *types.ResultDetails, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
+	// the result is written to a local file in standalone mode, so there is no uid to set
+	if chaosDetails.ResultFile != "" {
+		return nil
+	}
+
 	result, err := clients.GetChaosResult(chaosDetails, resultDetails)
 	if err != nil {
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosResultCRUD, Target: fmt.Sprintf("{name: %s, namespace: %s}", resultDetails.Name, chaosDetails.ChaosNamespace), Reason: err.Error()}
@@ -248,12 +320,24 @@ func RecordAfterFailure(chaosDetails *types.ChaosDetails, resultDetails *types.R
 	failStep, errorCode := cerrors.GetRootCauseAndErrorCode(err, string(chaosDetails.Phase))
 	phase := v1alpha1.ResultPhaseError
 	verdict := v1alpha1.ResultVerdictError
-	if probe.IsProbeFailed(failStep) {
+	switch {
+	case probe.IsProbeFailed(failStep):
 		phase = v1alpha1.ResultPhaseCompleted
 		verdict = v1alpha1.ResultVerdictFailed
+	case errorCode == cerrors.ErrorTypeExperimentAborted:
+		// the experiment is aborted, such as outside the execution windows
+		phase = v1alpha1.ResultPhaseStopped
+		verdict = v1alpha1.ResultVerdictStopped
 	}
 	// update the chaos result
 	types.SetResultAfterCompletion(resultDetails, verdict, phase, failStep, errorCode)
+	// the reason recorded by the abort watcher is preserved
+	if verdict == v1alpha1.ResultVerdictStopped && resultDetails.ErrorOutput == nil {
+		resultDetails.ErrorOutput = &v1alpha1.ErrorOutput{
+			Reason:    failStep,
+			ErrorCode: string(errorCode),
+		}
+	}
 	if err := ChaosResult(chaosDetails, clients, resultDetails, "EOT"); err != nil {
 		log.Errorf("failed to update chaosresult, err: %v", err)
 	}
@@ -290,6 +374,11 @@ func updateHistory(result *v1alpha1.ChaosResult) {
 // AnnotateChaosResult annotate the chaosResult for the chaos status
 // using kubectl cli to annotate the chaosresult as it will automatically handle the race condition in case of multiple helpers
 func AnnotateChaosResult(resultName, namespace, status, kind, name string) error {
+	// the result is written to a local file by the experiment in standalone mode, there is no chaosresult to annotate
+	if os.Getenv(StandaloneEnv) == "true" {
+		telemetry.RecordTargetStatus(kind, name, status)
+		return nil
+	}
 	command := exec.Command("kubectl", "annotate", "chaosresult", resultName, "-n", namespace, kind+"/"+name+"="+status, "--overwrite")
 	var out, stderr bytes.Buffer
 	command.Stdout = &out
@@ -298,6 +387,7 @@ func AnnotateChaosResult(resultName, namespace, status, kind, name string) error
 		log.Infof("Error String: %v", stderr.String())
 		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosResultCRUD, Target: fmt.Sprintf("{name: %s, namespace: %s}", resultName, namespace), Reason: out.String()}
 	}
+	telemetry.RecordTargetStatus(kind, name, status)
 	return nil
 }
 
@@ -0,0 +1,49 @@
+package result
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+// writeResultFile creates or updates the chaosresult inside the local result file
+// it replaces the chaosresult CR in standalone mode, where the litmus CRDs may not be installed
+// the file is replaced at SOT, so that the result of a previous run isn't merged into the current one
+func writeResultFile(chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails, state string) error {
+	chaosResultLabel := map[string]string{"chaosUID": string(chaosDetails.ChaosUID)}
+
+	result := &v1alpha1.ChaosResult{}
+	data, err := os.ReadFile(chaosDetails.ResultFile)
+	switch {
+	case state == "SOT":
+		result = newChaosResult(chaosDetails, resultDetails, chaosResultLabel)
+	case err == nil:
+		if err := json.Unmarshal(data, result); err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosResultCRUD, Target: fmt.Sprintf("{file: %s}", chaosDetails.ResultFile), Reason: fmt.Sprintf("failed to parse the result file: %s", err.Error())}
+		}
+	case os.IsNotExist(err):
+		result = newChaosResult(chaosDetails, resultDetails, chaosResultLabel)
+	default:
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosResultCRUD, Target: fmt.Sprintf("{file: %s}", chaosDetails.ResultFile), Reason: fmt.Sprintf("failed to read the result file: %s", err.Error())}
+	}
+
+	if state != "SOT" && resultDetails.Phase == v1alpha1.ResultPhaseRunning {
+		resultDetails.Phase = v1alpha1.ResultPhaseCompleted
+	}
+	if err := setResultAttributes(result, chaosDetails, resultDetails, chaosResultLabel); err != nil {
+		return err
+	}
+
+	data, err = json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosResultCRUD, Target: fmt.Sprintf("{file: %s}", chaosDetails.ResultFile), Reason: fmt.Sprintf("failed to marshal the result: %s", err.Error())}
+	}
+	if err := os.WriteFile(chaosDetails.ResultFile, data, 0644); err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeChaosResultCRUD, Target: fmt.Sprintf("{file: %s}", chaosDetails.ResultFile), Reason: fmt.Sprintf("failed to write the result file: %s", err.Error())}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package result
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+func TestWriteResultFile(t *testing.T) {
+	chaosDetails := &types.ChaosDetails{ExperimentName: "pod-delete", ChaosNamespace: "litmus", ChaosUID: "uid-1", ResultFile: filepath.Join(t.TempDir(), "result.json")}
+	previous := v1alpha1.ChaosResult{Spec: v1alpha1.ChaosResultSpec{ExperimentName: "pod-delete"},
+		Status: v1alpha1.ChaosResultStatus{ExperimentStatus: v1alpha1.TestStatus{Phase: v1alpha1.ResultPhaseCompleted, Verdict: v1alpha1.ResultVerdictFailed},
+			History: &v1alpha1.HistoryDetails{FailedRuns: 3}}}
+	data, err := json.Marshal(previous)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(chaosDetails.ResultFile, data, 0644))
+
+	read := func() v1alpha1.ChaosResult {
+		var result v1alpha1.ChaosResult
+		data, err := os.ReadFile(chaosDetails.ResultFile)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &result))
+		return result
+	}
+
+	// the result of the previous run is replaced at SOT
+	resultDetails := &types.ResultDetails{Name: "pod-delete", Phase: v1alpha1.ResultPhaseRunning, Verdict: v1alpha1.ResultVerdictAwaited}
+	require.NoError(t, writeResultFile(chaosDetails, resultDetails, "SOT"))
+	result := read()
+	assert.Equal(t, v1alpha1.ResultPhaseRunning, result.Status.ExperimentStatus.Phase)
+	assert.Equal(t, 0, int(result.Status.History.FailedRuns))
+
+	// and updated at EOT
+	resultDetails.Verdict = v1alpha1.ResultVerdictPassed
+	require.NoError(t, writeResultFile(chaosDetails, resultDetails, "EOT"))
+	result = read()
+	assert.Equal(t, v1alpha1.ResultPhaseCompleted, result.Status.ExperimentStatus.Phase)
+	assert.Equal(t, v1alpha1.ResultVerdictPassed, result.Status.ExperimentStatus.Verdict)
+	assert.Equal(t, 0, int(result.Status.History.FailedRuns))
+}
@@ -0,0 +1,71 @@
+package scenario
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"sigs.k8s.io/yaml"
+)
+
+// Scenario contains the details of a standalone experiment run,
+// it replaces the ChaosEngine and the ENVs rendered by the chaos-operator
+type Scenario struct {
+	// Experiment is the name of the experiment
+	Experiment string `json:"experiment"`
+	// Env contains the experiment ENVs, such as the targets and the tunables
+	Env map[string]string `json:"env,omitempty"`
+	// Probes contains the probes, executed around the chaos injection
+	Probes []v1alpha1.ProbeAttributes `json:"probes,omitempty"`
+	// ResultFile is the local file, which contains the chaosresult after the run
+	ResultFile string `json:"resultFile,omitempty"`
+}
+
+type contextKey struct{}
+
+// Load reads the scenario from the given YAML or JSON file
+func Load(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{scenario: %s}", path), Reason: fmt.Sprintf("failed to read the scenario: %s", err.Error())}
+	}
+
+	var scenario Scenario
+	if err := yaml.UnmarshalStrict(data, &scenario); err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{scenario: %s}", path), Reason: fmt.Sprintf("failed to parse the scenario: %s", err.Error())}
+	}
+	if scenario.Experiment == "" {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{scenario: %s}", path), Reason: "experiment name can't be empty"}
+	}
+	if scenario.ResultFile == "" {
+		scenario.ResultFile = scenario.Experiment + "-result.json"
+	}
+	return &scenario, nil
+}
+
+// Apply exports the scenario ENVs, so that they are read by the experiment like the operator rendered ENVs
+func (scenario *Scenario) Apply() error {
+	env := map[string]string{"EXPERIMENT_NAME": scenario.Experiment}
+	for k, v := range scenario.Env {
+		env[k] = v
+	}
+	for k, v := range env {
+		if err := os.Setenv(k, v); err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("failed to set the %s env: %s", k, err.Error())}
+		}
+	}
+	return nil
+}
+
+// NewContext returns a copy of the given context, which carries the scenario
+func NewContext(ctx context.Context, scenario *Scenario) context.Context {
+	return context.WithValue(ctx, contextKey{}, scenario)
+}
+
+// FromContext returns the scenario carried by the given context, if any
+func FromContext(ctx context.Context) (*Scenario, bool) {
+	scenario, ok := ctx.Value(contextKey{}).(*Scenario)
+	return scenario, ok
+}
@@ -0,0 +1,127 @@
+package scenario
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeScenario(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected *Scenario
+		wantErr  bool
+	}{
+		{
+			name: "yaml scenario",
+			file: "scenario.yaml",
+			content: `
+experiment: pod-delete
+env:
+  TARGETS: deployment:default:[app=nginx]
+  TOTAL_CHAOS_DURATION: "30"
+probes:
+  - name: check-frontend
+    type: httpProbe
+    mode: Continuous
+    httpProbe/inputs:
+      url: http://frontend.default.svc:8080
+      method:
+        get:
+          criteria: ==
+          responseCode: "200"
+    runProperties:
+      probeTimeout: 5s
+      interval: 2s
+`,
+			expected: &Scenario{
+				Experiment: "pod-delete",
+				Env: map[string]string{
+					"TARGETS":              "deployment:default:[app=nginx]",
+					"TOTAL_CHAOS_DURATION": "30",
+				},
+				ResultFile: "pod-delete-result.json",
+			},
+		},
+		{
+			name:    "json scenario",
+			file:    "scenario.json",
+			content: `{"experiment": "container-kill", "resultFile": "/tmp/result.json"}`,
+			expected: &Scenario{
+				Experiment: "container-kill",
+				ResultFile: "/tmp/result.json",
+			},
+		},
+		{
+			name:    "missing experiment",
+			file:    "scenario.yaml",
+			content: "env:\n  TARGETS: deployment:default:[app=nginx]\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			file:    "scenario.yaml",
+			content: "experiment: pod-delete\ntunables:\n  TOTAL_CHAOS_DURATION: 30\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenario, err := Load(writeScenario(t, tt.file, tt.content))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected.Experiment, scenario.Experiment)
+			assert.Equal(t, tt.expected.Env, scenario.Env)
+			assert.Equal(t, tt.expected.ResultFile, scenario.ResultFile)
+		})
+	}
+}
+
+func TestLoadProbes(t *testing.T) {
+	scenario, err := Load(writeScenario(t, "scenario.yaml", `
+experiment: pod-delete
+probes:
+  - name: check-frontend
+    type: httpProbe
+    mode: Edge
+    httpProbe/inputs:
+      url: http://frontend.default.svc:8080
+`))
+	require.NoError(t, err)
+	require.Len(t, scenario.Probes, 1)
+	assert.Equal(t, "check-frontend", scenario.Probes[0].Name)
+	assert.Equal(t, "http://frontend.default.svc:8080", scenario.Probes[0].HTTPProbeInputs.URL)
+}
+
+func TestApply(t *testing.T) {
+	t.Setenv("EXPERIMENT_NAME", "")
+	t.Setenv("TOTAL_CHAOS_DURATION", "")
+
+	scenario := &Scenario{Experiment: "pod-delete", Env: map[string]string{"TOTAL_CHAOS_DURATION": "60"}}
+	require.NoError(t, scenario.Apply())
+	assert.Equal(t, "pod-delete", os.Getenv("EXPERIMENT_NAME"))
+	assert.Equal(t, "60", os.Getenv("TOTAL_CHAOS_DURATION"))
+
+	ctx := NewContext(context.Background(), scenario)
+	s, ok := FromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, scenario, s)
+
+	_, ok = FromContext(context.Background())
+	assert.False(t, ok)
+}
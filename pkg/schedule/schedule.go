@@ -0,0 +1,254 @@
+// Package schedule restricts the chaos injection to the execution windows and keeps it out of the blackout dates
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// the timezones are resolved even if the image doesn't contain the zoneinfo database
+	_ "time/tzdata"
+
+	"github.com/litmuschaos/litmus-go/pkg/abort"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/stringutils"
+)
+
+const dateLayout = "2006-01-02"
+
+// Schedule contains the execution windows and the blackout dates of the experiment
+type Schedule struct {
+	windows   []window
+	blackouts []dateRange
+	location  *time.Location
+	// source contains the tunables, which are reported inside the reasons
+	source string
+}
+
+// window is a cron expression of the allowed minutes, i.e, minute hour day-of-month month day-of-week
+type window struct {
+	minute, hour, dom, month, dow field
+}
+
+// field contains the allowed values of a cron field, star marks the unrestricted fields
+type field struct {
+	values map[int]bool
+	star   bool
+}
+
+// dateRange is an inclusive range of the dates, in the dateLayout
+type dateRange struct {
+	from, to string
+}
+
+// New parses the semicolon separated execution windows and the comma separated blackout dates
+// the blackout dates are either a single date, such as 2026-12-24, or a range, such as 2026-12-20..2027-01-02
+func New(windows, blackoutDates, timezone string) (*Schedule, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid EXECUTION_TIMEZONE %q: %v", timezone, err)
+	}
+	s := &Schedule{location: location, source: windows}
+
+	for _, expr := range strings.Split(windows, ";") {
+		if strings.TrimSpace(expr) == "" {
+			continue
+		}
+		w, err := parseWindow(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EXECUTION_WINDOWS %q: %v", expr, err)
+		}
+		s.windows = append(s.windows, w)
+	}
+
+	for _, date := range stringutils.SplitList(blackoutDates) {
+		r, err := parseDateRange(date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid BLACKOUT_DATES %q: %v", date, err)
+		}
+		s.blackouts = append(s.blackouts, r)
+	}
+	return s, nil
+}
+
+// Allowed checks whether the chaos is allowed at the given time, it returns the reason otherwise
+func (s *Schedule) Allowed(t time.Time) (bool, string) {
+	t = t.In(s.location)
+	date := t.Format(dateLayout)
+	for _, r := range s.blackouts {
+		if date >= r.from && date <= r.to {
+			return false, fmt.Sprintf("%s is a blackout date (%s)", date, r)
+		}
+	}
+
+	if len(s.windows) == 0 {
+		return true, ""
+	}
+	for _, w := range s.windows {
+		if w.matches(t) {
+			return true, ""
+		}
+	}
+	return false, fmt.Sprintf("%s is outside the execution windows (%s)", t.Format("2006-01-02 15:04 MST"), s.source)
+}
+
+// Check verifies that the chaos is allowed now, as per the schedule tunables of the experiment
+// it returns the aborted error outside the execution windows or during the blackout dates
+func Check(chaosDetails *types.ChaosDetails) error {
+	if chaosDetails.ExecutionWindows == "" && chaosDetails.BlackoutDates == "" {
+		return nil
+	}
+	s, err := New(chaosDetails.ExecutionWindows, chaosDetails.BlackoutDates, chaosDetails.ExecutionTimezone)
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: err.Error()}
+	}
+	if ok, reason := s.Allowed(time.Now()); !ok {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeExperimentAborted, Reason: "chaos is not allowed as " + reason}
+	}
+	return nil
+}
+
+// Watch keeps checking the schedule at the start of every minute, until the context is cancelled
+// it aborts the experiment once the execution window is closed or a blackout date begins, so that the chaos is reverted
+func Watch(ctx context.Context, chaosDetails *types.ChaosDetails) {
+	if chaosDetails.ExecutionWindows == "" && chaosDetails.BlackoutDates == "" {
+		return
+	}
+	s, err := New(chaosDetails.ExecutionWindows, chaosDetails.BlackoutDates, chaosDetails.ExecutionTimezone)
+	if err != nil {
+		log.Errorf("Unable to watch the execution windows, err: %v", err)
+		return
+	}
+
+	for {
+		now := time.Now()
+		timer := time.NewTimer(now.Truncate(time.Minute).Add(time.Minute).Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case t := <-timer.C:
+			if ok, reason := s.Allowed(t); !ok {
+				log.Errorf("[Abort]: Chaos is not allowed anymore as %v, reverting the chaos", reason)
+				abort.Trigger("execution window is closed, " + reason)
+				return
+			}
+		}
+	}
+}
+
+func (r dateRange) String() string {
+	if r.from == r.to {
+		return r.from
+	}
+	return r.from + ".." + r.to
+}
+
+// matches checks whether the given time matches the window
+// the day is matched by either of the day-of-month and the day-of-week, if both of them are restricted, as in cron
+func (w window) matches(t time.Time) bool {
+	if !w.minute.values[t.Minute()] || !w.hour.values[t.Hour()] || !w.month.values[int(t.Month())] {
+		return false
+	}
+	dom, dow := w.dom.values[t.Day()], w.dow.values[int(t.Weekday())]
+	if w.dom.star || w.dow.star {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// parseWindow parses the five fields of the cron expression
+func parseWindow(expr string) (window, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return window{}, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), found %d", len(fields))
+	}
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	var parsed [5]field
+	for i := range fields {
+		f, err := parseField(fields[i], bounds[i][0], bounds[i][1])
+		if err != nil {
+			return window{}, err
+		}
+		parsed[i] = f
+	}
+	// both 0 and 7 are sunday
+	if parsed[4].values[7] {
+		parsed[4].values[0] = true
+	}
+	return window{minute: parsed[0], hour: parsed[1], dom: parsed[2], month: parsed[3], dow: parsed[4]}, nil
+}
+
+// parseField parses the comma separated values, ranges and steps of a cron field, such as */15 or 1-5 or 9,12-14
+func parseField(expr string, min, max int) (field, error) {
+	f := field{values: map[int]bool{}, star: expr == "*"}
+	for _, item := range strings.Split(expr, ",") {
+		step, stepped := 1, false
+		if i := strings.Index(item, "/"); i != -1 {
+			s, err := strconv.Atoi(item[i+1:])
+			if err != nil || s <= 0 {
+				return field{}, fmt.Errorf("invalid step in %q", item)
+			}
+			step, stepped, item = s, true, item[:i]
+		}
+
+		from, to := min, max
+		switch {
+		case item == "*":
+		case strings.Contains(item, "-"):
+			bounds := strings.SplitN(item, "-", 2)
+			var err error
+			if from, err = parseValue(bounds[0], min, max); err != nil {
+				return field{}, err
+			}
+			if to, err = parseValue(bounds[1], min, max); err != nil {
+				return field{}, err
+			}
+			if from > to {
+				return field{}, fmt.Errorf("invalid range %q", item)
+			}
+		default:
+			value, err := parseValue(item, min, max)
+			if err != nil {
+				return field{}, err
+			}
+			from = value
+			// a single value with a step runs till the maximum, as in cron
+			if !stepped {
+				to = value
+			}
+		}
+
+		for v := from; v <= to; v += step {
+			f.values[v] = true
+		}
+	}
+	return f, nil
+}
+
+func parseValue(s string, min, max int) (int, error) {
+	value, err := strconv.Atoi(s)
+	if err != nil || value < min || value > max {
+		return 0, fmt.Errorf("%q is not a number between %d and %d", s, min, max)
+	}
+	return value, nil
+}
+
+// parseDateRange parses the single date or the range of the dates
+func parseDateRange(s string) (dateRange, error) {
+	bounds := strings.SplitN(s, "..", 2)
+	for _, b := range bounds {
+		if _, err := time.Parse(dateLayout, strings.TrimSpace(b)); err != nil {
+			return dateRange{}, fmt.Errorf("expected the dates in the YYYY-MM-DD format")
+		}
+	}
+	r := dateRange{from: strings.TrimSpace(bounds[0]), to: strings.TrimSpace(bounds[len(bounds)-1])}
+	if r.from > r.to {
+		return dateRange{}, fmt.Errorf("the range ends before it begins")
+	}
+	return r, nil
+}
@@ -0,0 +1,60 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowed(t *testing.T) {
+	// 2026-10-16 is a friday
+	friday := time.Date(2026, 10, 16, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		windows   string
+		blackouts string
+		timezone  string
+		at        time.Time
+		allowed   bool
+	}{
+		{name: "no restrictions", timezone: "UTC", at: friday, allowed: true},
+		{name: "inside business hours", windows: "* 10-15 * * 1-5", timezone: "UTC", at: friday, allowed: true},
+		{name: "outside business hours", windows: "* 10-15 * * 1-5", timezone: "UTC", at: friday.Add(4 * time.Hour)},
+		{name: "weekend", windows: "* 10-15 * * 1-5", timezone: "UTC", at: friday.Add(24 * time.Hour)},
+		{name: "any of the windows", windows: "* 10-11 * * *; * 12 * * 5", timezone: "UTC", at: friday, allowed: true},
+		{name: "minute steps", windows: "*/15 * * * *", timezone: "UTC", at: friday.Add(15 * time.Minute), allowed: true},
+		{name: "minute steps mismatch", windows: "*/15 * * * *", timezone: "UTC", at: friday.Add(time.Minute)},
+		{name: "day of month or day of week", windows: "* * 1 * 5", timezone: "UTC", at: friday, allowed: true},
+		{name: "sunday as 7", windows: "* * * * 7", timezone: "UTC", at: friday.Add(48 * time.Hour), allowed: true},
+		{name: "timezone", windows: "* 10-15 * * *", timezone: "Asia/Kolkata", at: friday},
+		{name: "blackout date", blackouts: "2026-10-16", timezone: "UTC", at: friday},
+		{name: "blackout range", windows: "* * * * *", blackouts: "2026-12-24, 2026-10-10..2026-10-20", timezone: "UTC", at: friday},
+		{name: "after the blackout range", blackouts: "2026-10-10..2026-10-15", timezone: "UTC", at: friday, allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(tt.windows, tt.blackouts, tt.timezone)
+			assert.NoError(t, err)
+			allowed, reason := s.Allowed(tt.at)
+			assert.Equal(t, tt.allowed, allowed, reason)
+		})
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	for _, tt := range []struct{ windows, blackouts, timezone string }{
+		{windows: "* 10-15 * *", timezone: "UTC"},
+		{windows: "* 15-10 * * *", timezone: "UTC"},
+		{windows: "*/0 * * * *", timezone: "UTC"},
+		{windows: "60 * * * *", timezone: "UTC"},
+		{blackouts: "24-12-2026", timezone: "UTC"},
+		{blackouts: "2026-12-24..2026-12-20", timezone: "UTC"},
+		{timezone: "Mars/Olympus"},
+	} {
+		_, err := New(tt.windows, tt.blackouts, tt.timezone)
+		assert.Error(t, err, tt)
+	}
+}
@@ -2,48 +2,41 @@ package environment
 
 import (
 	"encoding/json"
-	"strconv"
 	"strings"
 
-	clientTypes "k8s.io/apimachinery/pkg/types"
-
 	experimentTypes "github.com/litmuschaos/litmus-go/pkg/spring-boot/spring-boot-chaos/types"
 	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils/tunables"
 )
 
+// assaultTunables contains the chaos monkey assault parameters
+type assaultTunables struct {
+	Level                 int     `env:"CM_LEVEL" default:"1" min:"1"`
+	Latency               int     `env:"LATENCY" default:"2000" unit:"ms" min:"0"`
+	MemoryFillFraction    float64 `env:"MEMORY_FILL_FRACTION" default:"0.7" min:"0" max:"1"`
+	CPULoadFraction       float64 `env:"CPU_LOAD_FRACTION" default:"0.9" min:"0" max:"1"`
+	KillApplicationActive bool    `env:"CM_KILL_APPLICATION_ACTIVE" default:"false"`
+	LatencyActive         bool    `env:"CM_LATENCY_ACTIVE" default:"false"`
+	MemoryActive          bool    `env:"CM_MEMORY_ACTIVE" default:"false"`
+	CPUActive             bool    `env:"CM_CPU_ACTIVE" default:"false"`
+	ExceptionsActive      bool    `env:"CM_EXCEPTIONS_ACTIVE" default:"false"`
+}
+
 // GetENV fetches all the env variables from the runner pod
-func GetENV(experimentDetails *experimentTypes.ExperimentDetails, expName string) {
-	experimentDetails.ExperimentName = types.Getenv("EXPERIMENT_NAME", expName)
-	experimentDetails.ChaosNamespace = types.Getenv("CHAOS_NAMESPACE", "litmus")
-	experimentDetails.EngineName = types.Getenv("CHAOSENGINE", "")
-	experimentDetails.ChaosDuration, _ = strconv.Atoi(types.Getenv("TOTAL_CHAOS_DURATION", "30"))
-	experimentDetails.ChaosInterval, _ = strconv.Atoi(types.Getenv("CHAOS_INTERVAL", "10"))
-	experimentDetails.RampTime, _ = strconv.Atoi(types.Getenv("RAMP_TIME", "0"))
-	experimentDetails.ChaosUID = clientTypes.UID(types.Getenv("CHAOS_UID", ""))
-	experimentDetails.InstanceID = types.Getenv("INSTANCE_ID", "")
-	experimentDetails.ChaosPodName = types.Getenv("POD_NAME", "")
-	experimentDetails.Delay, _ = strconv.Atoi(types.Getenv("STATUS_CHECK_DELAY", "2"))
-	experimentDetails.Timeout, _ = strconv.Atoi(types.Getenv("STATUS_CHECK_TIMEOUT", "180"))
-	experimentDetails.TargetContainer = types.Getenv("TARGET_CONTAINER", "")
-	experimentDetails.TargetPods = types.Getenv("TARGET_PODS", "")
-	experimentDetails.PodsAffectedPerc, _ = strconv.Atoi(types.Getenv("PODS_AFFECTED_PERC", "0"))
-	experimentDetails.Sequence = types.Getenv("SEQUENCE", "serial")
+func GetENV(experimentDetails *experimentTypes.ExperimentDetails, expName string) error {
+	err := tunables.Load(experimentDetails, map[string]string{"EXPERIMENT_NAME": expName})
 
 	// Chaos monkey assault parameters
-	experimentDetails.ChaosMonkeyPath = types.Getenv("CM_PATH", "/actuator/chaosmonkey")
-	experimentDetails.ChaosMonkeyPort = types.Getenv("CM_PORT", "8080")
+	var params assaultTunables
+	err = tunables.Join(err, tunables.Load(&params, nil))
 
-	level, _ := strconv.Atoi(types.Getenv("CM_LEVEL", "1"))
 	watchedCustomServices := strings.Split(types.Getenv("CM_WATCHED_CUSTOM_SERVICES", ""), ",")
 	commonAssaults := experimentTypes.CommonAssault{
-		Level:                 level,
+		Level:                 params.Level,
 		Deterministic:         true,
 		WatchedCustomServices: watchedCustomServices,
 	}
 
-	latency, _ := strconv.Atoi(types.Getenv("LATENCY", "2000"))
-	memoryFillPercentage, _ := strconv.ParseFloat(types.Getenv("MEMORY_FILL_FRACTION", "0.7"), 64)
-	cpuLoadTargetFraction, _ := strconv.ParseFloat(types.Getenv("CPU_LOAD_FRACTION", "0.9"), 64)
 	exceptionAssault := getExceptionAssault()
 
 	switch expName {
@@ -53,26 +46,26 @@ func GetENV(experimentDetails *experimentTypes.ExperimentDetails, expName string
 		assault := experimentTypes.AllAssault{
 			CommonAssault: commonAssaults,
 		}
-		assault.KillApplicationActive, _ = strconv.ParseBool(types.Getenv("CM_KILL_APPLICATION_ACTIVE", "false"))
+		assault.KillApplicationActive = params.KillApplicationActive
 		assault.KillApplicationCron = "*/1 * * * * ?"
 
-		assault.LatencyActive, _ = strconv.ParseBool(types.Getenv("CM_LATENCY_ACTIVE", "false"))
-		assault.LatencyRangeStart = latency
-		assault.LatencyRangeEnd = latency
+		assault.LatencyActive = params.LatencyActive
+		assault.LatencyRangeStart = params.Latency
+		assault.LatencyRangeEnd = params.Latency
 
-		assault.MemoryActive, _ = strconv.ParseBool(types.Getenv("CM_MEMORY_ACTIVE", "false"))
+		assault.MemoryActive = params.MemoryActive
 		assault.MemoryMillisecondsHoldFilledMemory = experimentDetails.ChaosDuration * 1000
 		assault.MemoryMillisecondsWaitNextIncrease = 1000
 		assault.MemoryFillIncrementFraction = 1.0
 		assault.MemoryCron = "*/1 * * * * ?"
-		assault.MemoryFillTargetFraction = memoryFillPercentage
+		assault.MemoryFillTargetFraction = params.MemoryFillFraction
 
-		assault.CPUActive, _ = strconv.ParseBool(types.Getenv("CM_CPU_ACTIVE", "false"))
+		assault.CPUActive = params.CPUActive
 		assault.CPUMillisecondsHoldLoad = experimentDetails.ChaosDuration * 1000
-		assault.CPULoadTargetFraction = cpuLoadTargetFraction
+		assault.CPULoadTargetFraction = params.CPULoadFraction
 		assault.CPUCron = "*/1 * * * * ?"
 
-		assault.ExceptionsActive, _ = strconv.ParseBool(types.Getenv("CM_EXCEPTIONS_ACTIVE", "false"))
+		assault.ExceptionsActive = params.ExceptionsActive
 		assault.Exception = exceptionAssault
 
 		experimentDetails.ChaosMonkeyAssault, _ = json.Marshal(assault)
@@ -89,8 +82,8 @@ func GetENV(experimentDetails *experimentTypes.ExperimentDetails, expName string
 		assault := experimentTypes.LatencyAssault{
 			CommonAssault:     commonAssaults,
 			LatencyActive:     true,
-			LatencyRangeStart: latency,
-			LatencyRangeEnd:   latency,
+			LatencyRangeStart: params.Latency,
+			LatencyRangeEnd:   params.Latency,
 		}
 		experimentDetails.ChaosMonkeyAssault, _ = json.Marshal(assault)
 	case "spring-boot-memory-stress":
@@ -102,7 +95,7 @@ func GetENV(experimentDetails *experimentTypes.ExperimentDetails, expName string
 			MemoryMillisecondsWaitNextIncrease: 1000,
 			MemoryFillIncrementFraction:        1.0,
 			MemoryCron:                         "*/1 * * * * ?",
-			MemoryFillTargetFraction:           memoryFillPercentage,
+			MemoryFillTargetFraction:           params.MemoryFillFraction,
 		}
 		experimentDetails.ChaosMonkeyAssault, _ = json.Marshal(assault)
 	case "spring-boot-cpu-stress":
@@ -111,7 +104,7 @@ func GetENV(experimentDetails *experimentTypes.ExperimentDetails, expName string
 			CommonAssault:           commonAssaults,
 			CPUActive:               true,
 			CPUMillisecondsHoldLoad: experimentDetails.ChaosDuration * 1000,
-			CPULoadTargetFraction:   cpuLoadTargetFraction,
+			CPULoadTargetFraction:   params.CPULoadFraction,
 			CPUCron:                 "*/1 * * * * ?",
 		}
 		experimentDetails.ChaosMonkeyAssault, _ = json.Marshal(assault)
@@ -155,6 +148,7 @@ func GetENV(experimentDetails *experimentTypes.ExperimentDetails, expName string
 		}
 	}
 	experimentDetails.ChaosMonkeyWatchers = watchers
+	return err
 }
 
 func getExceptionAssault() experimentTypes.AssaultException {
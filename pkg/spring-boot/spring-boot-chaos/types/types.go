@@ -7,32 +7,32 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName     string
-	EngineName         string
-	ChaosDuration      int
-	ChaosInterval      int
-	RampTime           int
+	ExperimentName     string `env:"EXPERIMENT_NAME"`
+	EngineName         string `env:"CHAOSENGINE"`
+	ChaosDuration      int    `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	ChaosInterval      int    `env:"CHAOS_INTERVAL" default:"10" unit:"s" min:"0"`
+	RampTime           int    `env:"RAMP_TIME" default:"0" unit:"s" min:"0"`
 	AppNS              string
 	AppLabel           string
 	AppKind            string
-	ChaosUID           clientTypes.UID
-	InstanceID         string
-	ChaosNamespace     string
-	ChaosPodName       string
-	Timeout            int
-	Delay              int
-	TargetContainer    string
-	PodsAffectedPerc   int
-	TargetPods         string
+	ChaosUID           clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID         string          `env:"INSTANCE_ID"`
+	ChaosNamespace     string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName       string          `env:"POD_NAME"`
+	Timeout            int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay              int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	TargetContainer    string          `env:"TARGET_CONTAINER"`
+	PodsAffectedPerc   int             `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	TargetPods         string          `env:"TARGET_PODS"`
 	LIBImagePullPolicy string
-	Sequence           string
+	Sequence           string `env:"SEQUENCE" default:"serial"`
 	TargetPodList      v1.PodList
 
 	// Chaos monkey parameters
 	ChaosMonkeyAssault  []byte
 	ChaosMonkeyWatchers ChaosMonkeyWatchers
-	ChaosMonkeyPath     string
-	ChaosMonkeyPort     string
+	ChaosMonkeyPath     string `env:"CM_PATH" default:"/actuator/chaosmonkey"`
+	ChaosMonkeyPort     string `env:"CM_PORT" default:"8080"`
 }
 
 type ChaosMonkeyAssaultRevert struct {
@@ -12,6 +12,7 @@ import (
 
 	clients "github.com/litmuschaos/litmus-go/pkg/clients"
 	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/telemetry"
 	"github.com/litmuschaos/litmus-go/pkg/types"
 	"github.com/litmuschaos/litmus-go/pkg/utils/retry"
 	"github.com/litmuschaos/litmus-go/pkg/utils/stringutils"
@@ -246,7 +247,8 @@ func WaitForCompletion(appNs, appLabel string, clients clients.ClientSets, durat
 // and wait until the helper pod comes to one of the {running,completed,failed} states
 func CheckHelperStatus(appNs, appLabel string, timeout, delay int, clients clients.ClientSets) error {
 
-	return retry.
+	var helperPods []v1.Pod
+	err := retry.
 		Times(uint(timeout / delay)).
 		Wait(time.Duration(delay) * time.Second).
 		Try(func(attempt uint) error {
@@ -270,8 +272,32 @@ func CheckHelperStatus(appNs, appLabel string, timeout, delay int, clients clien
 					}
 				}
 			}
+			helperPods = podList.Items
 			return nil
 		})
+	if err != nil {
+		return err
+	}
+	recordHelperStartLatency(helperPods)
+	return nil
+}
+
+// recordHelperStartLatency records the time taken by the helper pods to start all their containers, after their creation
+func recordHelperStartLatency(helperPods []v1.Pod) {
+	for _, pod := range helperPods {
+		var startedAt time.Time
+		for _, container := range pod.Status.ContainerStatuses {
+			switch {
+			case container.State.Running != nil && container.State.Running.StartedAt.After(startedAt):
+				startedAt = container.State.Running.StartedAt.Time
+			case container.State.Terminated != nil && container.State.Terminated.StartedAt.After(startedAt):
+				startedAt = container.State.Terminated.StartedAt.Time
+			}
+		}
+		if !startedAt.IsZero() {
+			telemetry.RecordHelperStartLatency(startedAt.Sub(pod.CreationTimestamp.Time))
+		}
+	}
 }
 
 func CheckPodStatusByPodName(appNs, appName string, timeout, delay int, clients clients.ClientSets) error {
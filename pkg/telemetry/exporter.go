@@ -0,0 +1,258 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"google.golang.org/grpc/credentials"
+)
+
+// the standard OTLP exporter ENVs, along with the secret containing the TLS files
+const (
+	OTELExporterOTLPProtocol          = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	OTELExporterOTLPInsecure          = "OTEL_EXPORTER_OTLP_INSECURE"
+	OTELExporterOTLPCertificate       = "OTEL_EXPORTER_OTLP_CERTIFICATE"
+	OTELExporterOTLPClientCertificate = "OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE"
+	OTELExporterOTLPClientKey         = "OTEL_EXPORTER_OTLP_CLIENT_KEY"
+	OTELExporterOTLPHeaders           = "OTEL_EXPORTER_OTLP_HEADERS"
+	// OTELExporterOTLPTLSSecret is the secret, which contains the CA bundle and the client certificates
+	// it is mounted inside the helper pods at the directories of the certificate files
+	OTELExporterOTLPTLSSecret = "OTEL_EXPORTER_OTLP_TLS_SECRET"
+)
+
+// the supported OTLP protocols
+const (
+	ProtocolGRPC         = "grpc"
+	ProtocolHTTPProtobuf = "http/protobuf"
+)
+
+// ExporterENVs contains the ENVs of the OTLP exporter, which are passed from the experiment to the helper pods
+var ExporterENVs = []string{
+	OTELExporterOTLPEndpoint,
+	OTELExporterOTLPProtocol,
+	OTELExporterOTLPInsecure,
+	OTELExporterOTLPCertificate,
+	OTELExporterOTLPClientCertificate,
+	OTELExporterOTLPClientKey,
+	OTELExporterOTLPHeaders,
+	OTELExporterOTLPTLSSecret,
+}
+
+// ExporterConfig contains the settings of the OTLP exporter, derived from the OTEL_EXPORTER_OTLP_* ENVs
+type ExporterConfig struct {
+	Protocol string
+	// Endpoint is the host and port of the collector
+	Endpoint string
+	// URLPath is the base path of the collector, the signal path is appended to it for the http protocol
+	URLPath           string
+	Insecure          bool
+	Certificate       string
+	ClientCertificate string
+	ClientKey         string
+	Headers           map[string]string
+	// RawHeaders contains the headers in the format of the ENV, i.e, key1=value1,key2=value2
+	RawHeaders string
+	TLSSecret  string
+}
+
+// GetExporterConfig derives the exporter settings for the given endpoint from the ENVs
+// the exporter stays insecure unless a certificate or an https endpoint is provided, or it is disabled explicitly
+func GetExporterConfig(endpoint string) (ExporterConfig, error) {
+	config := ExporterConfig{
+		Protocol:          strings.TrimSpace(os.Getenv(OTELExporterOTLPProtocol)),
+		Endpoint:          endpoint,
+		Certificate:       os.Getenv(OTELExporterOTLPCertificate),
+		ClientCertificate: os.Getenv(OTELExporterOTLPClientCertificate),
+		ClientKey:         os.Getenv(OTELExporterOTLPClientKey),
+		RawHeaders:        os.Getenv(OTELExporterOTLPHeaders),
+		TLSSecret:         os.Getenv(OTELExporterOTLPTLSSecret),
+	}
+
+	switch config.Protocol {
+	case "":
+		config.Protocol = ProtocolGRPC
+	case ProtocolGRPC, ProtocolHTTPProtobuf:
+	default:
+		return config, exporterError(OTELExporterOTLPProtocol, config.Protocol, fmt.Sprintf("supported protocols are %s and %s", ProtocolGRPC, ProtocolHTTPProtobuf))
+	}
+
+	secureEndpoint := false
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Host == "" {
+			return config, exporterError(OTELExporterOTLPEndpoint, endpoint, "expected host:port or an absolute url")
+		}
+		config.Endpoint, config.URLPath = u.Host, strings.TrimSuffix(u.Path, "/")
+		secureEndpoint = u.Scheme == "https"
+	}
+
+	config.Insecure = !secureEndpoint && config.Certificate == "" && config.ClientCertificate == ""
+	if insecure := strings.TrimSpace(os.Getenv(OTELExporterOTLPInsecure)); insecure != "" {
+		var err error
+		if config.Insecure, err = strconv.ParseBool(insecure); err != nil {
+			return config, exporterError(OTELExporterOTLPInsecure, insecure, "expected a boolean")
+		}
+	}
+
+	if (config.ClientCertificate == "") != (config.ClientKey == "") {
+		return config, exporterError(OTELExporterOTLPClientKey, config.ClientKey, fmt.Sprintf("both %s and %s are required for the client authentication", OTELExporterOTLPClientCertificate, OTELExporterOTLPClientKey))
+	}
+
+	headers, err := parseHeaders(config.RawHeaders)
+	if err != nil {
+		return config, exporterError(OTELExporterOTLPHeaders, config.RawHeaders, err.Error())
+	}
+	config.Headers = headers
+	return config, nil
+}
+
+// CertificateDirs returns the unique directories of the certificate files, where the TLS secret is mounted
+func (config ExporterConfig) CertificateDirs() []string {
+	var dirs []string
+	seen := map[string]bool{}
+	for _, file := range []string{config.Certificate, config.ClientCertificate, config.ClientKey} {
+		if file == "" {
+			continue
+		}
+		if dir := path.Dir(file); !seen[dir] {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// tlsConfig builds the TLS config from the CA bundle and the client certificates
+func (config ExporterConfig) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if config.Certificate != "" {
+		ca, err := os.ReadFile(config.Certificate)
+		if err != nil {
+			return nil, exporterError(OTELExporterOTLPCertificate, config.Certificate, err.Error())
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, exporterError(OTELExporterOTLPCertificate, config.Certificate, "no valid certificate found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.ClientCertificate != "" {
+		cert, err := tls.LoadX509KeyPair(config.ClientCertificate, config.ClientKey)
+		if err != nil {
+			return nil, exporterError(OTELExporterOTLPClientCertificate, config.ClientCertificate, err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// parseHeaders parses the headers in the format of the ENV, the keys and values are url encoded
+func parseHeaders(raw string) (map[string]string, error) {
+	headers := map[string]string{}
+	for _, header := range strings.Split(raw, ",") {
+		if strings.TrimSpace(header) == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(header, "=")
+		if !ok {
+			return nil, fmt.Errorf("expected key=value pairs separated by commas")
+		}
+		key, err := url.PathUnescape(strings.TrimSpace(key))
+		if err != nil || key == "" {
+			return nil, fmt.Errorf("invalid header key %q", key)
+		}
+		if value, err = url.PathUnescape(strings.TrimSpace(value)); err != nil {
+			return nil, fmt.Errorf("invalid value of the %s header", key)
+		}
+		headers[key] = value
+	}
+	return headers, nil
+}
+
+func exporterError(name, value, reason string) error {
+	return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("invalid otlp exporter config: %s=%q: %s", name, value, reason)}
+}
+
+func newTraceExporter(ctx context.Context, config ExporterConfig) (*otlptrace.Exporter, error) {
+	if config.Protocol == ProtocolHTTPProtobuf {
+		opts := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(config.Endpoint),
+			otlptracehttp.WithURLPath(path.Join("/", config.URLPath, "v1/traces")),
+			otlptracehttp.WithHeaders(config.Headers),
+		}
+		if config.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		} else {
+			tlsConfig, err := config.tlsConfig()
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsConfig))
+		}
+		return otlptrace.New(ctx, otlptracehttp.NewClient(opts...))
+	}
+
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(config.Endpoint),
+		otlptracegrpc.WithHeaders(config.Headers),
+	}
+	if config.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	} else {
+		tlsConfig, err := config.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+	}
+	return otlptrace.New(ctx, otlptracegrpc.NewClient(opts...))
+}
+
+func newMetricExporter(ctx context.Context, config ExporterConfig) (sdkmetric.Exporter, error) {
+	if config.Protocol == ProtocolHTTPProtobuf {
+		opts := []otlpmetrichttp.Option{
+			otlpmetrichttp.WithEndpoint(config.Endpoint),
+			otlpmetrichttp.WithURLPath(path.Join("/", config.URLPath, "v1/metrics")),
+			otlpmetrichttp.WithHeaders(config.Headers),
+		}
+		if config.Insecure {
+			opts = append(opts, otlpmetrichttp.WithInsecure())
+		} else {
+			tlsConfig, err := config.tlsConfig()
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, otlpmetrichttp.WithTLSClientConfig(tlsConfig))
+		}
+		return otlpmetrichttp.New(ctx, opts...)
+	}
+
+	opts := []otlpmetricgrpc.Option{
+		otlpmetricgrpc.WithEndpoint(config.Endpoint),
+		otlpmetricgrpc.WithHeaders(config.Headers),
+	}
+	if config.Insecure {
+		opts = append(opts, otlpmetricgrpc.WithInsecure())
+	} else {
+		tlsConfig, err := config.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, otlpmetricgrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+	}
+	return otlpmetricgrpc.New(ctx, opts...)
+}
@@ -0,0 +1,85 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExporterConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		env      map[string]string
+		expected ExporterConfig
+		wantErr  bool
+	}{
+		{
+			name:     "insecure grpc by default",
+			endpoint: "otel-collector:4317",
+			expected: ExporterConfig{Protocol: ProtocolGRPC, Endpoint: "otel-collector:4317", Insecure: true, Headers: map[string]string{}},
+		},
+		{
+			name:     "mtls over http",
+			endpoint: "https://otel-collector:4318/otlp/",
+			env: map[string]string{
+				OTELExporterOTLPProtocol:          ProtocolHTTPProtobuf,
+				OTELExporterOTLPCertificate:       "/etc/otel/tls/ca.crt",
+				OTELExporterOTLPClientCertificate: "/etc/otel/tls/tls.crt",
+				OTELExporterOTLPClientKey:         "/etc/otel/tls/tls.key",
+				OTELExporterOTLPHeaders:           "Authorization=Bearer%20token, x-tenant=litmus",
+			},
+			expected: ExporterConfig{
+				Protocol:          ProtocolHTTPProtobuf,
+				Endpoint:          "otel-collector:4318",
+				URLPath:           "/otlp",
+				Certificate:       "/etc/otel/tls/ca.crt",
+				ClientCertificate: "/etc/otel/tls/tls.crt",
+				ClientKey:         "/etc/otel/tls/tls.key",
+				Headers:           map[string]string{"Authorization": "Bearer token", "x-tenant": "litmus"},
+				RawHeaders:        "Authorization=Bearer%20token, x-tenant=litmus",
+			},
+		},
+		{
+			name:     "explicitly insecure",
+			endpoint: "https://otel-collector:4317",
+			env:      map[string]string{OTELExporterOTLPInsecure: "true"},
+			expected: ExporterConfig{Protocol: ProtocolGRPC, Endpoint: "otel-collector:4317", Insecure: true, Headers: map[string]string{}},
+		},
+		{
+			name:     "unsupported protocol",
+			endpoint: "otel-collector:4317",
+			env:      map[string]string{OTELExporterOTLPProtocol: "http/json"},
+			wantErr:  true,
+		},
+		{
+			name:     "client certificate without key",
+			endpoint: "otel-collector:4317",
+			env:      map[string]string{OTELExporterOTLPClientCertificate: "/etc/otel/tls/tls.crt"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid headers",
+			endpoint: "otel-collector:4317",
+			env:      map[string]string{OTELExporterOTLPHeaders: "Authorization"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range ExporterENVs[1:] {
+				t.Setenv(name, tt.env[name])
+			}
+
+			config, err := GetExporterConfig(tt.endpoint)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, config)
+			assert.Equal(t, len(config.CertificateDirs()) != 0, config.Certificate != "")
+		})
+	}
+}
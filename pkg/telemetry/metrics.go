@@ -0,0 +1,134 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+const MeterName = "litmuschaos.io/litmus-go"
+
+// attribute keys of the chaos metrics
+const (
+	ExperimentKey   = attribute.Key("experiment")
+	EngineKey       = attribute.Key("engine")
+	NamespaceKey    = attribute.Key("namespace")
+	TargetKindKey   = attribute.Key("target.kind")
+	TargetNameKey   = attribute.Key("target.name")
+	ProbeNameKey    = attribute.Key("probe.name")
+	ProbeTypeKey    = attribute.Key("probe.type")
+	ProbePhaseKey   = attribute.Key("probe.phase")
+	ProbeVerdictKey = attribute.Key("probe.verdict")
+)
+
+// instruments contains the chaos metrics
+type instruments struct {
+	faultInjections    metric.Int64Counter
+	faultReverts       metric.Int64Counter
+	chaosDuration      metric.Float64Histogram
+	probeRuns          metric.Int64Counter
+	helperStartLatency metric.Float64Histogram
+}
+
+var (
+	// the instruments are created from the global meter provider,
+	// they are forwarded to the OTLP meter provider once it is set by InitOTelSDK
+	metrics = newInstruments(otel.Meter(MeterName))
+	// experimentAttributes are attached to all the chaos metrics
+	experimentAttributes []attribute.KeyValue
+)
+
+func newInstruments(meter metric.Meter) instruments {
+	var m instruments
+	var err, errs error
+
+	m.faultInjections, err = meter.Int64Counter("litmus.fault.injections",
+		metric.WithDescription("Number of fault injections per target"), metric.WithUnit("{injection}"))
+	errs = errors.Join(errs, err)
+	m.faultReverts, err = meter.Int64Counter("litmus.fault.reverts",
+		metric.WithDescription("Number of fault reverts per target"), metric.WithUnit("{revert}"))
+	errs = errors.Join(errs, err)
+	m.chaosDuration, err = meter.Float64Histogram("litmus.chaos.duration",
+		metric.WithDescription("Duration of the chaos injection"), metric.WithUnit("s"))
+	errs = errors.Join(errs, err)
+	m.probeRuns, err = meter.Int64Counter("litmus.probe.runs",
+		metric.WithDescription("Number of probe runs per verdict"), metric.WithUnit("{run}"))
+	errs = errors.Join(errs, err)
+	m.helperStartLatency, err = meter.Float64Histogram("litmus.helper.start_latency",
+		metric.WithDescription("Time taken by the helper pods to start after their creation"), metric.WithUnit("s"))
+	errs = errors.Join(errs, err)
+
+	if errs != nil {
+		otel.Handle(errs)
+	}
+	return m
+}
+
+func newMeterProvider(ctx context.Context, isExperiment bool, exporterConfig ExporterConfig) (*sdkmetric.MeterProvider, error) {
+	res, err := newResource(ctx, isExperiment)
+	if err != nil {
+		return nil, err
+	}
+	metricExporter, err := newMetricExporter(ctx, exporterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	meterProvider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(res),
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
+	)
+	return meterProvider, nil
+}
+
+// setExperimentAttributes derives the experiment attributes from the ENVs,
+// they are available inside both the experiment and the helper pods
+func setExperimentAttributes() {
+	experimentAttributes = []attribute.KeyValue{
+		ExperimentKey.String(os.Getenv("EXPERIMENT_NAME")),
+		EngineKey.String(os.Getenv("CHAOSENGINE")),
+		NamespaceKey.String(os.Getenv("CHAOS_NAMESPACE")),
+	}
+}
+
+// withAttributes returns the experiment attributes along with the given attributes
+func withAttributes(attrs ...attribute.KeyValue) metric.MeasurementOption {
+	return metric.WithAttributes(append(append([]attribute.KeyValue{}, experimentAttributes...), attrs...)...)
+}
+
+// RecordTargetStatus records the fault injection or revert on the given target, derived from its chaos status
+func RecordTargetStatus(kind, name, status string) {
+	target := withAttributes(TargetKindKey.String(kind), TargetNameKey.String(name))
+	switch status {
+	case "injected", "targeted", "detached":
+		metrics.faultInjections.Add(context.Background(), 1, target)
+	case "reverted", "re-attached":
+		metrics.faultReverts.Add(context.Background(), 1, target)
+	}
+}
+
+// RecordChaosDuration records the duration of the chaos injection
+func RecordChaosDuration(duration time.Duration) {
+	metrics.chaosDuration.Record(context.Background(), duration.Seconds(), withAttributes())
+}
+
+// RecordProbeRun records the run of the given probe along with its verdict
+func RecordProbeRun(name, probeType, phase, verdict string) {
+	metrics.probeRuns.Add(context.Background(), 1, withAttributes(
+		ProbeNameKey.String(name),
+		ProbeTypeKey.String(probeType),
+		ProbePhaseKey.String(phase),
+		ProbeVerdictKey.String(verdict),
+	))
+}
+
+// RecordHelperStartLatency records the time taken by a helper pod to start after its creation
+func RecordHelperStartLatency(latency time.Duration) {
+	metrics.helperStartLatency.Record(context.Background(), latency.Seconds(), withAttributes())
+}
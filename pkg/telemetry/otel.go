@@ -5,8 +5,6 @@ import (
 	"errors"
 
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
@@ -35,7 +33,13 @@ func InitOTelSDK(ctx context.Context, isExperiment bool, endpoint string) (shutd
 		err = errors.Join(inErr, shutdown(ctx))
 	}
 
-	tracerProvider, err := newTracerProvider(ctx, isExperiment, endpoint)
+	exporterConfig, err := GetExporterConfig(endpoint)
+	if err != nil {
+		handleErr(err)
+		return
+	}
+
+	tracerProvider, err := newTracerProvider(ctx, isExperiment, exporterConfig)
 	if err != nil {
 		handleErr(err)
 		return
@@ -47,7 +51,17 @@ func InitOTelSDK(ctx context.Context, isExperiment bool, endpoint string) (shutd
 	shutdownFuncs = append(shutdownFuncs, tracerProvider.Shutdown)
 	otel.SetTracerProvider(tracerProvider)
 
-	// TODO: need to add metrics & logging provider
+	meterProvider, err := newMeterProvider(ctx, isExperiment, exporterConfig)
+	if err != nil {
+		handleErr(err)
+		return
+	}
+
+	shutdownFuncs = append(shutdownFuncs, meterProvider.Shutdown)
+	otel.SetMeterProvider(meterProvider)
+	setExperimentAttributes()
+
+	// TODO: need to add logging provider
 	return
 }
 
@@ -58,24 +72,25 @@ func newPropagator() propagation.TextMapPropagator {
 	)
 }
 
-func newTracerProvider(ctx context.Context, isExperiment bool, endpoint string) (*trace.TracerProvider, error) {
+// newResource returns the resource of the experiment or helper, which is shared by the traces and metrics
+func newResource(ctx context.Context, isExperiment bool) (*resource.Resource, error) {
 	serviceName := OTELExperimentJobHelperServiceName
 	if isExperiment {
 		serviceName = OTELExperimentJobServiceName
 	}
-	res, err := resource.New(ctx,
+	return resource.New(ctx,
 		resource.WithAttributes(
 			semconv.ServiceNameKey.String(serviceName),
 		),
 	)
-	traceExporter, err := otlptrace.New(
-		ctx,
-		otlptracegrpc.NewClient(
-			// TODO: add secure option
-			otlptracegrpc.WithInsecure(),
-			otlptracegrpc.WithEndpoint(endpoint),
-		),
-	)
+}
+
+func newTracerProvider(ctx context.Context, isExperiment bool, exporterConfig ExporterConfig) (*trace.TracerProvider, error) {
+	res, err := newResource(ctx, isExperiment)
+	if err != nil {
+		return nil, err
+	}
+	traceExporter, err := newTraceExporter(ctx, exporterConfig)
 	if err != nil {
 		return nil, err
 	}
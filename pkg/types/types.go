@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
 	"github.com/litmuschaos/litmus-go/pkg/utils/stringutils"
+	"github.com/litmuschaos/litmus-go/pkg/utils/tunables"
 
 	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -108,22 +108,22 @@ type EventDetails struct {
 
 // ChaosDetails is for collecting all the global variables
 type ChaosDetails struct {
-	ChaosUID             clientTypes.UID
-	ChaosNamespace       string
-	ChaosPodName         string
-	EngineName           string
-	InstanceID           string
-	ExperimentName       string
-	Timeout              int
-	Delay                int
+	ChaosUID             clientTypes.UID `env:"CHAOS_UID"`
+	ChaosNamespace       string          `env:"CHAOS_NAMESPACE"`
+	ChaosPodName         string          `env:"POD_NAME"`
+	EngineName           string          `env:"CHAOSENGINE"`
+	InstanceID           string          `env:"INSTANCE_ID"`
+	ExperimentName       string          `env:"EXPERIMENT_NAME"`
+	Timeout              int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay                int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
 	AppDetail            []AppDetails
-	ChaosDuration        int
-	JobCleanupPolicy     string
-	ProbeImagePullPolicy string
-	Randomness           bool
+	ChaosDuration        int    `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	JobCleanupPolicy     string `env:"JOB_CLEANUP_POLICY" default:"retain"`
+	ProbeImagePullPolicy string `env:"LIB_IMAGE_PULL_POLICY" default:"Always"`
+	Randomness           bool   `env:"RANDOMNESS"`
 	Targets              []v1alpha1.TargetDetails
 	ParentsResources     []ParentResource
-	DefaultHealthCheck   bool
+	DefaultHealthCheck   bool `env:"DEFAULT_HEALTH_CHECK" default:"true"`
 	Annotations          map[string]string
 	Resources            corev1.ResourceRequirements
 	ImagePullSecrets     []corev1.LocalObjectReference
@@ -132,6 +132,90 @@ type ChaosDetails struct {
 	Phase                ExperimentPhase
 	ProbeContext         ProbeContext
 	SideCar              []SideCar
+	DryRun               bool `env:"DRY_RUN" default:"false"`
+	Plan                 []PlanStep
+	// Faults contains the statuses of the faults of the composite experiment
+	Faults []FaultStatus
+	// IntensitySteps contains the comma separated intensities of the ramp mode, such as 100,500,1000 for the latency
+	// the chaos duration is split across the steps, and the ramp stops at the first step where a probe fails
+	IntensitySteps string `env:"INTENSITY_STEPS"`
+	// Ramp contains the status of the ramp mode
+	Ramp *RampStatus
+	// ReportFile is the local file, which contains the report of the run in the ReportFormat
+	ReportFile   string `env:"REPORT_FILE"`
+	ReportFormat string `env:"REPORT_FORMAT" default:"json" oneof:"json,junit"`
+	// ReportConfigMap is the configmap, which contains the report of the run in all the formats
+	ReportConfigMap string `env:"REPORT_CONFIGMAP"`
+	// StartTime is the start time of the run, it is reported once the run completes or gets aborted
+	StartTime time.Time
+	// WebhookURLs contains the comma separated webhooks, which are notified about the lifecycle of the experiment
+	WebhookURLs string `env:"WEBHOOK_URLS"`
+	// WebhookSecret signs the webhook payloads with HMAC-SHA256, if provided
+	WebhookSecret  string `env:"WEBHOOK_SECRET"`
+	WebhookTimeout int    `env:"WEBHOOK_TIMEOUT" default:"5" unit:"s" min:"1"`
+	// AbortTimeout is the time to revert the chaos upon the failure of a probe with stopOnFailure, the chaosengine is stopped afterwards
+	AbortTimeout int `env:"ABORT_TIMEOUT" default:"60" unit:"s" min:"1"`
+	// DeniedNamespaces contains the comma separated namespaces, which are never targeted
+	DeniedNamespaces string `env:"DENIED_NAMESPACES"`
+	// ProtectedLabel is the label selector of the pods and nodes, which are never targeted
+	ProtectedLabel string `env:"PROTECTED_LABEL" default:"litmuschaos.io/protected=true"`
+	// MaxTargets and MaxTargetsPerc cap the number of targeted pods, nodes or instances, 0 disables the cap
+	MaxTargets     int `env:"MAX_TARGETS" min:"0"`
+	MaxTargetsPerc int `env:"MAX_TARGETS_PERC" min:"0" max:"100"`
+	// MinHealthyReplicas is the number of ready replicas per workload, which are never targeted
+	MinHealthyReplicas int `env:"MIN_HEALTHY_REPLICAS" min:"0"`
+	// ExecutionWindows contains the semicolon separated cron expressions of the minutes, when the chaos is allowed, such as "* 10-15 * * 1-5"
+	ExecutionWindows string `env:"EXECUTION_WINDOWS"`
+	// BlackoutDates contains the comma separated dates or date ranges, such as 2026-12-20..2027-01-02, when the chaos is never allowed
+	BlackoutDates string `env:"BLACKOUT_DATES"`
+	// ExecutionTimezone is the timezone of the execution windows and the blackout dates
+	ExecutionTimezone string `env:"EXECUTION_TIMEZONE" default:"UTC"`
+	// AlertmanagerEndpoint and AlertPrometheusEndpoint are the sources of the firing alerts, the chaos is not injected while the matching alerts are firing
+	AlertmanagerEndpoint    string `env:"ALERTMANAGER_ENDPOINT"`
+	AlertPrometheusEndpoint string `env:"ALERT_PROMETHEUS_ENDPOINT"`
+	// AlertMatchers contains the semicolon separated groups of the comma separated label matchers, such as severity=critical;service=checkout
+	AlertMatchers string `env:"ALERT_MATCHERS" default:"severity=critical"`
+	// AlertCheckInterval is the interval to check the firing alerts during the chaos injection
+	AlertCheckInterval int `env:"ALERT_CHECK_INTERVAL" default:"30" unit:"s" min:"1"`
+	// AlertBearerTokenPath, AlertUsername and AlertPasswordPath are the credentials of the alert sources, the secrets are read from the mounted files
+	AlertBearerTokenPath string `env:"ALERT_BEARER_TOKEN_PATH"`
+	AlertUsername        string `env:"ALERT_USERNAME"`
+	AlertPasswordPath    string `env:"ALERT_PASSWORD_PATH"`
+	// AlertCACert, AlertCert and AlertKey are the paths of the ca cert and the client cert of the alert sources
+	AlertCACert             string `env:"ALERT_CA_CERT"`
+	AlertCert               string `env:"ALERT_CERT"`
+	AlertKey                string `env:"ALERT_KEY"`
+	AlertInsecureSkipVerify bool   `env:"ALERT_INSECURE_SKIP_VERIFY"`
+	// AlertOrgID is sent inside the X-Scope-OrgID header to the multi-tenant alert sources, such as mimir and cortex
+	AlertOrgID string `env:"ALERT_ORG_ID"`
+	// ResultFile is the local file, which replaces the chaosresult in standalone mode
+	ResultFile string
+	// Probes contains the probes of the standalone scenario, they are read from the chaosengine otherwise
+	Probes []v1alpha1.ProbeAttributes
+}
+
+// PlanStep is an action of the experiment, it is recorded instead of being performed in dry-run mode
+type PlanStep struct {
+	Action  string            `json:"action"`
+	Target  string            `json:"target,omitempty"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// FaultStatus is the status of a fault of the composite experiment
+type FaultStatus struct {
+	Name      string `json:"name"`
+	Phase     string `json:"phase"`
+	FailStep  string `json:"failStep,omitempty"`
+	StartTime string `json:"startTime,omitempty"`
+	EndTime   string `json:"endTime,omitempty"`
+}
+
+// RampStatus is the status of the ramp mode, it contains the highest intensity survived by the target
+type RampStatus struct {
+	Tunable  string   `json:"tunable"`
+	Steps    []string `json:"steps"`
+	Survived string   `json:"survived,omitempty"`
+	Failed   string   `json:"failed,omitempty"`
 }
 
 type SideCar struct {
@@ -195,28 +279,18 @@ func parse(val string) []string {
 }
 
 // InitialiseChaosVariables initialise all the global variables
-func InitialiseChaosVariables(chaosDetails *ChaosDetails) {
+// the invalid tunables are returned as an error, once all the variables are initialised
+func InitialiseChaosVariables(chaosDetails *ChaosDetails) error {
 	targets := Getenv("TARGETS", "")
 	chaosDetails.AppDetail = GetTargets(strings.TrimSpace(targets))
 
-	chaosDetails.ChaosNamespace = Getenv("CHAOS_NAMESPACE", "")
-	chaosDetails.ChaosPodName = Getenv("POD_NAME", "")
-	chaosDetails.Randomness, _ = strconv.ParseBool(Getenv("RANDOMNESS", ""))
-	chaosDetails.ChaosDuration, _ = strconv.Atoi(Getenv("TOTAL_CHAOS_DURATION", "30"))
-	chaosDetails.ChaosUID = clientTypes.UID(Getenv("CHAOS_UID", ""))
-	chaosDetails.EngineName = Getenv("CHAOSENGINE", "")
-	chaosDetails.ExperimentName = Getenv("EXPERIMENT_NAME", "")
-	chaosDetails.InstanceID = Getenv("INSTANCE_ID", "")
-	chaosDetails.Timeout, _ = strconv.Atoi(Getenv("STATUS_CHECK_TIMEOUT", "180"))
-	chaosDetails.Delay, _ = strconv.Atoi(Getenv("STATUS_CHECK_DELAY", "2"))
-	chaosDetails.DefaultHealthCheck, _ = strconv.ParseBool(Getenv("DEFAULT_HEALTH_CHECK", "true"))
-	chaosDetails.JobCleanupPolicy = Getenv("JOB_CLEANUP_POLICY", "retain")
-	chaosDetails.ProbeImagePullPolicy = Getenv("LIB_IMAGE_PULL_POLICY", "Always")
+	err := tunables.Load(chaosDetails, nil)
 	chaosDetails.ParentsResources = []ParentResource{}
 	chaosDetails.Targets = []v1alpha1.TargetDetails{}
 	chaosDetails.Phase = PreChaosPhase
 	chaosDetails.ProbeContext.Ctx, chaosDetails.ProbeContext.CancelFunc = context.WithCancel(context.Background())
 	chaosDetails.Labels = map[string]string{}
+	return err
 }
 
 // SetResultAttributes initialise all the chaos result ENV
@@ -14,6 +14,8 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/abort"
 	"github.com/litmuschaos/litmus-go/pkg/cerrors"
 	"github.com/palantir/stacktrace"
 
@@ -21,8 +23,11 @@ import (
 	"github.com/litmuschaos/litmus-go/pkg/events"
 	"github.com/litmuschaos/litmus-go/pkg/log"
 	"github.com/litmuschaos/litmus-go/pkg/math"
+	"github.com/litmuschaos/litmus-go/pkg/report"
 	"github.com/litmuschaos/litmus-go/pkg/result"
+	"github.com/litmuschaos/litmus-go/pkg/telemetry"
 	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/webhook"
 	apiv1 "k8s.io/api/core/v1"
 )
 
@@ -80,20 +85,31 @@ func AbortWatcherWithoutExit(expname string, clients clients.ClientSets, resultD
 	// signChan channel is used to transmit signal notifications.
 	signChan := make(chan os.Signal, 1)
 	// Catch and relay certain signal(s) to signChan channel.
-	signal.Notify(signChan, os.Interrupt, syscall.SIGTERM)
+	NotifyAbort(signChan)
 
 	// waiting until the abort signal received
 	<-signChan
+	// the abort is handled once the chaosresult and the abort events are recorded
+	defer abort.Done()
 
 	log.Info("[Chaos]: Chaos Experiment Abortion started because of terminated signal received")
 	// updating the chaosresult after stopped
 	failStep := "Chaos injection stopped!"
 	types.SetResultAfterCompletion(resultDetails, "Stopped", "Stopped", failStep, cerrors.ErrorTypeExperimentAborted)
+	// the experiment is aborted from within upon a probe failure, the probe is recorded inside the chaosresult
+	if reason := abort.Reason(); reason != "" {
+		resultDetails.ErrorOutput = &v1alpha1.ErrorOutput{
+			Reason:    fmt.Sprintf("Chaos injection stopped as the %s", reason),
+			ErrorCode: string(cerrors.ErrorTypeExperimentAborted),
+		}
+	}
 	if err := result.ChaosResult(chaosDetails, clients, resultDetails, "EOT"); err != nil {
 		log.Errorf("[ABORT]: Failed to update result, err: %v", err)
 	}
 	log.Info("[ABORT]: Updated chaosresult post stop")
-
+	if err := report.Write(chaosDetails, resultDetails, clients, chaosDetails.StartTime); err != nil {
+		log.Errorf("[ABORT]: Failed to write the report, err: %v", err)
+	}
 	// generating summary event in chaosengine
 	msg := expname + " experiment has been aborted"
 	types.SetEngineEventAttributes(eventsDetails, types.Summary, msg, "Warning", chaosDetails)
@@ -108,6 +124,25 @@ func AbortWatcherWithoutExit(expname string, clients clients.ClientSets, resultD
 	if err != nil {
 		log.Errorf("[ABORT]: Failed to create chaosresult abort event, err: %v", err)
 	}
+
+	// the abort event is sent last, so that an unreachable webhook doesn't delay the chaosresult and the summary events
+	webhook.Notify(webhook.Abort, chaosDetails, resultDetails)
+	if !webhook.Flush(time.Duration(chaosDetails.WebhookTimeout) * time.Second) {
+		log.Errorf("[ABORT]: The webhooks are not notified within %vs", chaosDetails.WebhookTimeout)
+	}
+}
+
+// NotifyAbort relays the abort signals to the given channel,
+// along with the abort of the experiment from within, i.e. the cancellation of the abort context
+func NotifyAbort(c chan os.Signal) {
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-abort.Context().Done()
+		select {
+		case c <- syscall.SIGTERM:
+		default:
+		}
+	}()
 }
 
 // FilterBasedOnPercentage return the slice of list based on the the provided percentage
@@ -140,6 +175,19 @@ func (envDetails *ENVDetails) SetEnv(key, value string) *ENVDetails {
 	return envDetails
 }
 
+// SetOTelExporterEnv passes the OTLP exporter ENVs of the experiment to the helper pod
+func (envDetails *ENVDetails) SetOTelExporterEnv() *ENVDetails {
+	for _, name := range telemetry.ExporterENVs {
+		envDetails.SetEnv(name, os.Getenv(name))
+	}
+	return envDetails
+}
+
+// SetLogFormatEnv passes the log format of the experiment to the helper pod, so that the helper logs are formatted alike
+func (envDetails *ENVDetails) SetLogFormatEnv() *ENVDetails {
+	return envDetails.SetEnv(log.LogFormatENV, os.Getenv(log.LogFormatENV))
+}
+
 // SetEnvFromDownwardAPI sets the downapi env in envDetails struct
 func (envDetails *ENVDetails) SetEnvFromDownwardAPI(apiVersion string, fieldPath string) *ENVDetails {
 	if apiVersion != "" && fieldPath != "" {
@@ -317,6 +365,43 @@ func GetSidecarVolumes(chaosDetails *types.ChaosDetails) []apiv1.Volume {
 	return volumes
 }
 
+// MountOTelExporterSecret mounts the TLS secret of the OTLP exporter inside the main container of the helper pod,
+// at the directories of the certificate files, so that the helper pod reaches the collector like the experiment pod
+func MountOTelExporterSecret(pod *apiv1.Pod) {
+	secretName := os.Getenv(telemetry.OTELExporterOTLPTLSSecret)
+	if secretName == "" || os.Getenv(telemetry.OTELExporterOTLPEndpoint) == "" {
+		return
+	}
+	config := telemetry.ExporterConfig{
+		Certificate:       os.Getenv(telemetry.OTELExporterOTLPCertificate),
+		ClientCertificate: os.Getenv(telemetry.OTELExporterOTLPClientCertificate),
+		ClientKey:         os.Getenv(telemetry.OTELExporterOTLPClientKey),
+	}
+	dirs := config.CertificateDirs()
+	if len(dirs) == 0 {
+		return
+	}
+
+	const volumeName = "otel-exporter-tls"
+	k := int32(420)
+	pod.Spec.Volumes = append(pod.Spec.Volumes, apiv1.Volume{
+		Name: volumeName,
+		VolumeSource: apiv1.VolumeSource{
+			Secret: &apiv1.SecretVolumeSource{
+				SecretName:  secretName,
+				DefaultMode: &k,
+			},
+		},
+	})
+	for _, dir := range dirs {
+		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, apiv1.VolumeMount{
+			Name:      volumeName,
+			MountPath: dir,
+			ReadOnly:  true,
+		})
+	}
+}
+
 // GetContainerNames gets the name of the main and sidecar containers
 func GetContainerNames(chaosDetails *types.ChaosDetails) []string {
 	containerNames := []string{chaosDetails.ExperimentName}
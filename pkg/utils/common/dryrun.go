@@ -0,0 +1,64 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/result"
+	"github.com/litmuschaos/litmus-go/pkg/telemetry"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/sirupsen/logrus"
+	core_v1 "k8s.io/api/core/v1"
+)
+
+// RecordPlanStep appends the given action to the plan of the experiment
+func RecordPlanStep(action, target string, details map[string]string, chaosDetails *types.ChaosDetails) {
+	chaosDetails.Plan = append(chaosDetails.Plan, types.PlanStep{
+		Action:  action,
+		Target:  target,
+		Details: details,
+	})
+
+	fields := logrus.Fields{}
+	for k, v := range details {
+		fields[k] = v
+	}
+	log.InfoWithValues(fmt.Sprintf("[DryRun]: Planned to %v %v", action, target), fields)
+}
+
+// CreateHelperPod creates the given helper pod
+// in dry-run mode, the helper pod is recorded inside the plan instead of being created
+// in standalone mode, the helper pod is marked so that it doesn't annotate the chaosresult, which doesn't exist
+func CreateHelperPod(namespace string, helperPod *core_v1.Pod, chaosDetails *types.ChaosDetails, clients clients.ClientSets) error {
+	if chaosDetails.ResultFile != "" {
+		for i := range helperPod.Spec.Containers {
+			helperPod.Spec.Containers[i].Env = append(helperPod.Spec.Containers[i].Env, core_v1.EnvVar{Name: result.StandaloneEnv, Value: "true"})
+		}
+	}
+	if !chaosDetails.DryRun {
+		return clients.CreatePod(namespace, helperPod)
+	}
+
+	details := map[string]string{
+		"namespace": namespace,
+		"node":      helperPod.Spec.NodeName,
+	}
+	for _, c := range helperPod.Spec.Containers {
+		details[c.Name+".image"] = c.Image
+		details[c.Name+".command"] = strings.Join(append(append([]string{}, c.Command...), c.Args...), " ")
+		for _, env := range c.Env {
+			switch {
+			case env.Value == "":
+			case env.Name == telemetry.OTELExporterOTLPHeaders:
+				// the headers carry the auth tokens of the collector
+				details[c.Name+".env."+env.Name] = "<redacted>"
+			default:
+				details[c.Name+".env."+env.Name] = env.Value
+			}
+		}
+	}
+	RecordPlanStep("create helper pod", helperPod.Name, details, chaosDetails)
+	return nil
+}
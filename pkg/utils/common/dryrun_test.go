@@ -0,0 +1,63 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/result"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateHelperPodInDryRunMode(t *testing.T) {
+	chaosDetails := &types.ChaosDetails{DryRun: true}
+	helperPod := &core_v1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: "pod-network-latency-helper-abcd"},
+		Spec: core_v1.PodSpec{
+			NodeName: "node-1",
+			Containers: []core_v1.Container{
+				{
+					Name:    "pod-network-latency",
+					Image:   "litmuschaos/go-runner:latest",
+					Command: []string{"/bin/bash"},
+					Args:    []string{"-c", "./helpers -name network-chaos"},
+					Env: []core_v1.EnvVar{
+						{Name: "NETEM_COMMAND", Value: "delay 2000ms"},
+						{Name: "DESTINATION_IPS", Value: ""},
+					},
+				},
+			},
+		},
+	}
+
+	// the clientsets are empty, so the test fails if the helper pod is created
+	err := CreateHelperPod("litmus", helperPod, chaosDetails, clients.ClientSets{})
+	assert.NoError(t, err)
+	assert.Equal(t, []types.PlanStep{
+		{
+			Action: "create helper pod",
+			Target: "pod-network-latency-helper-abcd",
+			Details: map[string]string{
+				"namespace":                             "litmus",
+				"node":                                  "node-1",
+				"pod-network-latency.image":             "litmuschaos/go-runner:latest",
+				"pod-network-latency.command":           "/bin/bash -c ./helpers -name network-chaos",
+				"pod-network-latency.env.NETEM_COMMAND": "delay 2000ms",
+			},
+		},
+	}, chaosDetails.Plan)
+}
+
+func TestCreateHelperPodInStandaloneMode(t *testing.T) {
+	chaosDetails := &types.ChaosDetails{DryRun: true, ResultFile: "pod-network-latency-result.json"}
+	helperPod := &core_v1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: "pod-network-latency-helper-abcd"},
+		Spec:       core_v1.PodSpec{Containers: []core_v1.Container{{Name: "pod-network-latency"}}},
+	}
+
+	err := CreateHelperPod("litmus", helperPod, chaosDetails, clients.ClientSets{})
+	assert.NoError(t, err)
+	assert.Equal(t, "true", chaosDetails.Plan[0].Details["pod-network-latency.env."+result.StandaloneEnv])
+}
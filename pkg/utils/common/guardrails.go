@@ -0,0 +1,201 @@
+package common
+
+import (
+	"fmt"
+
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/litmuschaos/litmus-go/pkg/utils"
+	"github.com/litmuschaos/litmus-go/pkg/utils/stringutils"
+	"github.com/litmuschaos/litmus-go/pkg/workloads"
+)
+
+// the guardrails limit the blast radius of the experiments
+// the target selection is refused if the selected targets breach any of them, rather than injecting a subset
+
+// CheckPodGuardrails verifies the target pods against the denied namespaces, the protected label,
+// the caps on the targets and the minimum healthy replicas of their workloads
+func CheckPodGuardrails(pods core_v1.PodList, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
+	protected, err := protectedSelector(chaosDetails.ProtectedLabel)
+	if err != nil {
+		return err
+	}
+	denied := stringutils.SplitList(chaosDetails.DeniedNamespaces)
+
+	for _, pod := range pods.Items {
+		target := fmt.Sprintf("{podName: %s, namespace: %s}", pod.Name, pod.Namespace)
+		if utils.Contains(pod.Namespace, denied) {
+			return guardrailError(target, fmt.Sprintf("namespace %s is denied by DENIED_NAMESPACES", pod.Namespace))
+		}
+		if protected != nil && protected.Matches(labels.Set(pod.Labels)) {
+			return guardrailError(target, fmt.Sprintf("pod is protected by the %s label", chaosDetails.ProtectedLabel))
+		}
+	}
+	if err := checkMaxTargets("pods", len(pods.Items), chaosDetails); err != nil {
+		return err
+	}
+
+	// the workloads of the targets are derived only if the guardrails require them
+	if chaosDetails.MaxTargetsPerc == 0 && chaosDetails.MinHealthyReplicas == 0 {
+		return nil
+	}
+	return checkWorkloadGuardrails(pods, clients, chaosDetails)
+}
+
+// CheckNodeGuardrails verifies the target nodes against the protected label and the caps on the targets
+// the percentage is derived out of all the nodes of the cluster
+func CheckNodeGuardrails(nodes []string, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
+	if err := checkMaxTargets("nodes", len(nodes), chaosDetails); err != nil {
+		return err
+	}
+	protected, err := protectedSelector(chaosDetails.ProtectedLabel)
+	if err != nil {
+		return err
+	}
+	if protected == nil && chaosDetails.MaxTargetsPerc == 0 {
+		return nil
+	}
+
+	allNodes, err := clients.GetAllNode(chaosDetails.Timeout, chaosDetails.Delay)
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: fmt.Sprintf("failed to list all nodes: %s", err.Error())}
+	}
+	if protected != nil {
+		for _, node := range allNodes.Items {
+			if utils.Contains(node.Name, nodes) && protected.Matches(labels.Set(node.Labels)) {
+				return guardrailError(fmt.Sprintf("{nodeName: %s}", node.Name), fmt.Sprintf("node is protected by the %s label", chaosDetails.ProtectedLabel))
+			}
+		}
+	}
+	return checkMaxTargetsPerc("nodes", fmt.Sprintf("{nodes: %v}", nodes), len(nodes), len(allNodes.Items), chaosDetails)
+}
+
+// CheckInstanceGuardrails verifies the target cloud instances or volumes against the caps on the targets
+// the percentage is derived out of all the candidates, i.e, the instances matching the tag or the label
+// it is skipped for a zero total, i.e, for the targets given by their ids, which have no candidates
+func CheckInstanceGuardrails(kind string, targets []string, total int, chaosDetails *types.ChaosDetails) error {
+	if err := checkMaxTargets(kind, len(targets), chaosDetails); err != nil {
+		return err
+	}
+	return checkMaxTargetsPerc(kind, fmt.Sprintf("{%s: %v}", kind, targets), len(targets), total, chaosDetails)
+}
+
+// checkWorkloadGuardrails verifies the targeted share and the untouched healthy replicas of the workloads of the target pods
+// the pods without a parent workload are not verified
+func checkWorkloadGuardrails(pods core_v1.PodList, clients clients.ClientSets, chaosDetails *types.ChaosDetails) error {
+	targeted := map[string]bool{}
+	var namespaces []string
+	for _, pod := range pods.Items {
+		targeted[pod.Namespace+"/"+pod.Name] = true
+		if !utils.Contains(pod.Namespace, namespaces) {
+			namespaces = append(namespaces, pod.Namespace)
+		}
+	}
+
+	for _, ns := range namespaces {
+		allPods, err := clients.GetAllPod(ns)
+		if err != nil {
+			return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Target: fmt.Sprintf("{namespace: %s}", ns), Reason: err.Error()}
+		}
+
+		// group the pods of the namespace by their parent workloads
+		var names []string
+		replicas := map[string][]core_v1.Pod{}
+		for _, pod := range allPods.Items {
+			kind, name, err := workloads.GetPodOwnerTypeAndName(&pod, clients.DynamicClient)
+			if err != nil {
+				return err
+			}
+			if kind == "" || name == "" {
+				continue
+			}
+			workload := fmt.Sprintf("{namespace: %s, kind: %s, name: %s}", ns, kind, name)
+			if _, ok := replicas[workload]; !ok {
+				names = append(names, workload)
+			}
+			replicas[workload] = append(replicas[workload], pod)
+		}
+
+		for _, workload := range names {
+			if err := checkWorkload(workload, replicas[workload], targeted, chaosDetails); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkWorkload verifies the targeted share and the untouched healthy replicas of the given workload
+func checkWorkload(workload string, replicas []core_v1.Pod, targeted map[string]bool, chaosDetails *types.ChaosDetails) error {
+	count, healthy := 0, 0
+	for _, pod := range replicas {
+		switch {
+		case targeted[pod.Namespace+"/"+pod.Name]:
+			count++
+		case isPodReady(pod):
+			healthy++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	if err := checkMaxTargetsPerc("pods", workload, count, len(replicas), chaosDetails); err != nil {
+		return err
+	}
+	if healthy < chaosDetails.MinHealthyReplicas {
+		return guardrailError(workload, fmt.Sprintf("%d of %d replicas are targeted, leaving %d healthy replicas untouched, MIN_HEALTHY_REPLICAS is %d", count, len(replicas), healthy, chaosDetails.MinHealthyReplicas))
+	}
+	return nil
+}
+
+// checkMaxTargets verifies the number of targets against MAX_TARGETS
+func checkMaxTargets(kind string, count int, chaosDetails *types.ChaosDetails) error {
+	if chaosDetails.MaxTargets != 0 && count > chaosDetails.MaxTargets {
+		return guardrailError("", fmt.Sprintf("%d %s are targeted, MAX_TARGETS is %d", count, kind, chaosDetails.MaxTargets))
+	}
+	return nil
+}
+
+// checkMaxTargetsPerc verifies the share of the targets out of the total against MAX_TARGETS_PERC
+func checkMaxTargetsPerc(kind, target string, count, total int, chaosDetails *types.ChaosDetails) error {
+	if chaosDetails.MaxTargetsPerc == 0 || total == 0 {
+		return nil
+	}
+	if count*100 > chaosDetails.MaxTargetsPerc*total {
+		return guardrailError(target, fmt.Sprintf("%d of %d %s are targeted, MAX_TARGETS_PERC is %d%%", count, total, kind, chaosDetails.MaxTargetsPerc))
+	}
+	return nil
+}
+
+// protectedSelector parses the protected label, it returns nil if no label is provided
+func protectedSelector(protectedLabel string) (labels.Selector, error) {
+	if protectedLabel == "" {
+		return nil, nil
+	}
+	selector, err := labels.Parse(protectedLabel)
+	if err != nil {
+		return nil, cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Reason: fmt.Sprintf("invalid PROTECTED_LABEL %q: %s", protectedLabel, err.Error())}
+	}
+	return selector, nil
+}
+
+// isPodReady checks whether the given pod is running and ready
+func isPodReady(pod core_v1.Pod) bool {
+	if pod.Status.Phase != core_v1.PodRunning || pod.DeletionTimestamp != nil {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == core_v1.PodReady {
+			return condition.Status == core_v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func guardrailError(target, reason string) error {
+	return cerrors.Error{ErrorCode: cerrors.ErrorTypeTargetSelection, Target: target, Reason: "guardrail breached: " + reason}
+}
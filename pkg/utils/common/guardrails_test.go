@@ -0,0 +1,145 @@
+package common
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core_v1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/clients"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+func readyPod(name string) core_v1.Pod {
+	return core_v1.Pod{
+		ObjectMeta: v1.ObjectMeta{Name: name, Namespace: "default"},
+		Status: core_v1.PodStatus{
+			Phase:      core_v1.PodRunning,
+			Conditions: []core_v1.PodCondition{{Type: core_v1.PodReady, Status: core_v1.ConditionTrue}},
+		},
+	}
+}
+
+func TestCheckPodGuardrails(t *testing.T) {
+	pods := core_v1.PodList{Items: []core_v1.Pod{readyPod("nginx-1"), readyPod("nginx-2")}}
+	pods.Items[1].Labels = map[string]string{"litmuschaos.io/protected": "true"}
+
+	tests := []struct {
+		name         string
+		chaosDetails types.ChaosDetails
+		wantErr      bool
+	}{
+		{name: "no guardrails", chaosDetails: types.ChaosDetails{}},
+		{name: "denied namespace", chaosDetails: types.ChaosDetails{DeniedNamespaces: "kube-system, default"}, wantErr: true},
+		{name: "protected label", chaosDetails: types.ChaosDetails{ProtectedLabel: "litmuschaos.io/protected=true"}, wantErr: true},
+		{name: "unrelated protected label", chaosDetails: types.ChaosDetails{ProtectedLabel: "tier=database"}},
+		{name: "max targets", chaosDetails: types.ChaosDetails{MaxTargets: 1}, wantErr: true},
+		{name: "invalid protected label", chaosDetails: types.ChaosDetails{ProtectedLabel: "a=b=c"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckPodGuardrails(pods, clients.ClientSets{}, &tt.chaosDetails)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Equal(t, cerrors.ErrorTypeTargetSelection, err.(cerrors.Error).ErrorCode)
+		})
+	}
+}
+
+func TestCheckWorkload(t *testing.T) {
+	notReady := readyPod("nginx-4")
+	notReady.Status.Conditions[0].Status = core_v1.ConditionFalse
+	replicas := []core_v1.Pod{readyPod("nginx-1"), readyPod("nginx-2"), readyPod("nginx-3"), notReady}
+
+	tests := []struct {
+		name         string
+		targeted     []string
+		chaosDetails types.ChaosDetails
+		wantErr      bool
+	}{
+		{name: "untargeted workload", targeted: nil, chaosDetails: types.ChaosDetails{MaxTargetsPerc: 1, MinHealthyReplicas: 4}},
+		{name: "within percentage", targeted: []string{"nginx-1", "nginx-2"}, chaosDetails: types.ChaosDetails{MaxTargetsPerc: 50}},
+		{name: "above percentage", targeted: []string{"nginx-1", "nginx-2", "nginx-3"}, chaosDetails: types.ChaosDetails{MaxTargetsPerc: 50}, wantErr: true},
+		{name: "enough healthy replicas", targeted: []string{"nginx-1"}, chaosDetails: types.ChaosDetails{MinHealthyReplicas: 2}},
+		{name: "unready replicas are not healthy", targeted: []string{"nginx-1", "nginx-2"}, chaosDetails: types.ChaosDetails{MinHealthyReplicas: 2}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targeted := map[string]bool{}
+			for _, name := range tt.targeted {
+				targeted["default/"+name] = true
+			}
+			err := checkWorkload("{namespace: default, kind: deployment, name: nginx}", replicas, targeted, &tt.chaosDetails)
+			assert.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestCheckInstanceGuardrails(t *testing.T) {
+	tests := []struct {
+		name         string
+		kind         string
+		targets      []string
+		candidates   int
+		chaosDetails types.ChaosDetails
+		wantErr      bool
+	}{
+		{name: "instances within the caps", kind: "instances", targets: []string{"i-1", "i-2"}, candidates: 4, chaosDetails: types.ChaosDetails{MaxTargets: 2, MaxTargetsPerc: 50}},
+		{name: "instances above the percentage", kind: "instances", targets: []string{"i-1", "i-2"}, candidates: 4, chaosDetails: types.ChaosDetails{MaxTargetsPerc: 25}, wantErr: true},
+		{name: "instances above the max targets", kind: "instances", targets: []string{"i-1", "i-2"}, candidates: 4, chaosDetails: types.ChaosDetails{MaxTargets: 1}, wantErr: true},
+		{name: "instances by id skip the percentage", kind: "instances", targets: []string{"i-1", "i-2"}, chaosDetails: types.ChaosDetails{MaxTargetsPerc: 25}},
+		{name: "instances by id above the max targets", kind: "instances", targets: []string{"i-1", "i-2"}, chaosDetails: types.ChaosDetails{MaxTargets: 1}, wantErr: true},
+		{name: "volumes by label within the percentage", kind: "volumes", targets: []string{"disk-1"}, candidates: 4, chaosDetails: types.ChaosDetails{MaxTargetsPerc: 25}},
+		{name: "volumes by label above the percentage", kind: "volumes", targets: []string{"disk-1", "disk-2", "disk-3"}, candidates: 4, chaosDetails: types.ChaosDetails{MaxTargetsPerc: 50}, wantErr: true},
+		{name: "volumes by name above the max targets", kind: "volumes", targets: []string{"disk-1", "disk-2"}, chaosDetails: types.ChaosDetails{MaxTargets: 1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckInstanceGuardrails(tt.kind, tt.targets, tt.candidates, &tt.chaosDetails)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Equal(t, cerrors.ErrorTypeTargetSelection, err.(cerrors.Error).ErrorCode)
+			assert.Contains(t, err.Error(), tt.kind)
+		})
+	}
+}
+
+func TestGetNodeName(t *testing.T) {
+	nodes := core_v1.NodeList{Items: []core_v1.Node{
+		{ObjectMeta: v1.ObjectMeta{Name: "node-1", Labels: map[string]string{"litmuschaos.io/protected": "true"}}},
+		{ObjectMeta: v1.ObjectMeta{Name: "node-2"}},
+	}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(nodes)
+	}))
+	defer server.Close()
+
+	kubeClient, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	assert.NoError(t, err)
+	clientSets := clients.ClientSets{KubeClient: kubeClient}
+	chaosDetails := &types.ChaosDetails{ProtectedLabel: "litmuschaos.io/protected=true", Timeout: 1, Delay: 1}
+
+	nodeName, err := GetNodeName("node-2", "", "", "", clientSets, chaosDetails)
+	assert.NoError(t, err)
+	assert.Equal(t, "node-2", nodeName)
+
+	_, err = GetNodeName("node-1", "", "", "", clientSets, chaosDetails)
+	assert.Error(t, err)
+}
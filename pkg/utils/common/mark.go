@@ -0,0 +1,17 @@
+package common
+
+// the helpers mark the chaos they leave inside the targets, such as the iptables rules, the qdiscs and the processes
+// the revert helper removes only the marked leftovers, so that the identical resources of the users are left untouched
+const (
+	// ChaosMarkPrefix is the prefix of the mark, it is followed by the chaos uid
+	ChaosMarkPrefix = "litmus-"
+	// ChaosMarkEnv is the ENV, which marks the processes launched by the helpers inside the targets
+	ChaosMarkEnv = "LITMUS_CHAOS_MARK"
+	// NetemHandle is the handle of the netem qdiscs, added by the network-chaos helper
+	NetemHandle = "ca05:"
+)
+
+// ChaosMark returns the mark of the chaos with the given uid
+func ChaosMark(chaosUID string) string {
+	return ChaosMarkPrefix + chaosUID
+}
@@ -23,13 +23,16 @@ var err error
 
 // GetNodeList check for the availability of the application node for the chaos execution
 // if the application node is not defined it will derive the random target node list using node affected percentage
-func GetNodeList(nodeNames, nodeLabel string, nodeAffPerc int, clients clients.ClientSets) ([]string, error) {
+func GetNodeList(nodeNames, nodeLabel string, nodeAffPerc int, clients clients.ClientSets, chaosDetails *types.ChaosDetails) ([]string, error) {
 
 	var nodeList []string
 	var nodes *apiv1.NodeList
 
 	if nodeNames != "" {
 		targetNodesList := stringutils.SplitList(nodeNames)
+		if err := CheckNodeGuardrails(targetNodesList, clients, chaosDetails); err != nil {
+			return nil, stacktrace.Propagate(err, "could not select target nodes")
+		}
 		return targetNodesList, nil
 	}
 
@@ -56,13 +59,33 @@ func GetNodeList(nodeNames, nodeLabel string, nodeAffPerc int, clients clients.C
 		index = (index + 1) % len(nodes.Items)
 	}
 
+	if err := CheckNodeGuardrails(nodeList, clients, chaosDetails); err != nil {
+		return nil, stacktrace.Propagate(err, "could not select target nodes")
+	}
+
 	log.Infof("[Chaos]:Number of nodes targeted: %v", strconv.Itoa(newNodeListLength))
 
 	return nodeList, nil
 }
 
-// GetNodeName will select a random replica of application pod and return the node name of that application pod
-func GetNodeName(namespace, labels, nodeLabel string, clients clients.ClientSets) (string, error) {
+// GetNodeName check for the availability of the application node for the chaos execution
+// if the application node is not defined it will select a random replica of application pod and return the node name of that application pod
+func GetNodeName(nodeName, namespace, labels, nodeLabel string, clients clients.ClientSets, chaosDetails *types.ChaosDetails) (string, error) {
+	if nodeName == "" {
+		nodeName, err = selectNodeName(namespace, labels, nodeLabel, clients)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if err := CheckNodeGuardrails([]string{nodeName}, clients, chaosDetails); err != nil {
+		return "", stacktrace.Propagate(err, "could not select target node")
+	}
+	return nodeName, nil
+}
+
+// selectNodeName will select a random replica of application pod and return the node name of that application pod
+func selectNodeName(namespace, labels, nodeLabel string, clients clients.ClientSets) (string, error) {
 
 	switch nodeLabel {
 	case "":
@@ -106,6 +129,11 @@ func getNodesByLabels(nodeLabel string, clients clients.ClientSets) (*apiv1.Node
 }
 
 func checkHelperStatus(appLabel string, chaosDetails *types.ChaosDetails, clients clients.ClientSets) error {
+	// the helper pods are not created in dry-run mode
+	if chaosDetails.DryRun {
+		return nil
+	}
+
 	// Checking the status of the helper pods
 	// if the pod doesn't transition to the 'running' state within a specified timeout period, consider the experiment unsuccessful
 	log.Info("[Status]: Checking the status of the helper pods")
@@ -141,6 +169,11 @@ func CheckHelperStatusAndRunProbes(ctx context.Context, appLabel, node string, c
 }
 
 func WaitForCompletionAndDeleteHelperPods(appLabel string, chaosDetails *types.ChaosDetails, clients clients.ClientSets, podLevel bool) error {
+	// the helper pods are not created in dry-run mode
+	if chaosDetails.DryRun {
+		return nil
+	}
+
 	// Wait till the completion of helper pod
 	log.Info("[Wait]: Waiting till the completion of the helper pod")
 	podStatus, err := status.WaitForCompletion(chaosDetails.ChaosNamespace, appLabel, clients, chaosDetails.ChaosDuration+chaosDetails.Timeout, GetContainerNames(chaosDetails)...)
@@ -19,6 +19,7 @@ import (
 	"github.com/litmuschaos/litmus-go/pkg/clients"
 	"github.com/litmuschaos/litmus-go/pkg/log"
 	"github.com/litmuschaos/litmus-go/pkg/math"
+	"github.com/litmuschaos/litmus-go/pkg/telemetry"
 	"github.com/litmuschaos/litmus-go/pkg/types"
 	"github.com/litmuschaos/litmus-go/pkg/utils/retry"
 	"github.com/litmuschaos/litmus-go/pkg/utils/stringutils"
@@ -244,6 +245,10 @@ func GetTargetPodsWhenTargetPodsENVSet(targetPods, namespace string, clients cli
 
 // SetTargets set the target details in chaosdetails struct
 func SetTargets(target, chaosStatus, kind string, chaosDetails *types.ChaosDetails) {
+	if !chaosDetails.DryRun {
+		telemetry.RecordTargetStatus(kind, target, chaosStatus)
+	}
+	log.InfoWithValues("[Status]: The chaos status of the target is updated", logrus.Fields{log.TargetField: kind + "/" + target, "Status": chaosStatus})
 
 	for i := range chaosDetails.Targets {
 		if chaosDetails.Targets[i].Name == target {
@@ -614,6 +619,10 @@ func GetTargetPods(nodeLabel, targetPods, podsAffectedPerc, podTerminationOrder
 		}
 	}
 
+	if err := CheckPodGuardrails(pods, clients, chaosDetails); err != nil {
+		return core_v1.PodList{}, stacktrace.Propagate(err, "could not select target pods")
+	}
+
 	podNames := []string{}
 	for _, pod := range pods.Items {
 		podNames = append(podNames, pod.Name)
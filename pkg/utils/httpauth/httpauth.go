@@ -0,0 +1,94 @@
+// Package httpauth contains the credentials of the http and grpc clients, such as the ones of the probes and the alert sources
+package httpauth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// TLS contains the TLS attributes of the clients
+type TLS struct {
+	CACert             string `json:"caCert,omitempty"`
+	Cert               string `json:"cert,omitempty"`
+	Key                string `json:"key,omitempty"`
+	ServerName         string `json:"serverName,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// Config returns the TLS config, it loads the ca cert and the client cert from the given paths
+func (t *TLS) Config() (*tls.Config, error) {
+	tlsConfig := &tls.Config{ServerName: t.ServerName, InsecureSkipVerify: t.InsecureSkipVerify}
+	if t.CACert != "" {
+		ca, err := os.ReadFile(t.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the ca cert: %v", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found inside the ca cert %s", t.CACert)
+		}
+	}
+	if t.Cert != "" || t.Key != "" {
+		cert, err := tls.LoadX509KeyPair(t.Cert, t.Key)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the client cert: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// Auth contains the bearer token or the basic auth credentials of the http clients
+// the credentials can be read from the files, such as the secrets mounted inside the experiment pod
+type Auth struct {
+	// BearerToken is sent inside the authorization header, BearerTokenPath reads it from the file
+	BearerToken     string `json:"bearerToken,omitempty"`
+	BearerTokenPath string `json:"bearerTokenPath,omitempty"`
+	// BasicAuth contains the username and the password of the basic auth
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+}
+
+// BasicAuth contains the basic auth credentials, the password is read from the PasswordPath if provided
+type BasicAuth struct {
+	Username     string `json:"username"`
+	Password     string `json:"password,omitempty"`
+	PasswordPath string `json:"passwordPath,omitempty"`
+}
+
+// Validate verifies that only one of the bearer token and the basic auth is provided
+func (a Auth) Validate() error {
+	if (a.BearerToken != "" || a.BearerTokenPath != "") && a.BasicAuth != nil {
+		return fmt.Errorf("only one of the bearer token and the basic auth can be provided")
+	}
+	return nil
+}
+
+// Set sets the authorization header of the request
+// the token and the password files are read on every request, so that the rotated credentials are picked up
+func (a Auth) Set(req *http.Request) error {
+	switch {
+	case a.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+a.BearerToken)
+	case a.BearerTokenPath != "":
+		token, err := os.ReadFile(a.BearerTokenPath)
+		if err != nil {
+			return fmt.Errorf("unable to read the bearer token: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	case a.BasicAuth != nil:
+		password := a.BasicAuth.Password
+		if a.BasicAuth.PasswordPath != "" {
+			content, err := os.ReadFile(a.BasicAuth.PasswordPath)
+			if err != nil {
+				return fmt.Errorf("unable to read the password: %v", err)
+			}
+			password = strings.TrimSpace(string(content))
+		}
+		req.SetBasicAuth(a.BasicAuth.Username, password)
+	}
+	return nil
+}
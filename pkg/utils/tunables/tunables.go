@@ -0,0 +1,234 @@
+package tunables
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+)
+
+// units contains the supported duration units of the numeric tunables
+var units = map[string]time.Duration{
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+}
+
+// Load reads the ENVs into the tagged fields of the given struct pointer
+// the fields are tagged with the name of the ENV and optionally its default value, duration unit and range:
+//
+//	ChaosDuration int `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+//
+// the fields with a unit accept either a plain number in that unit or a duration, such as 2m or 500ms
+// the string fields with a range accept either an integer or an interval of integers, such as 20-40
+// the string fields with a oneof tag accept only one of its comma separated values
+// the defaults map overrides the tagged defaults of the given ENVs, it is used by the experiments sharing the same details
+// every invalid value is collected into a single error, the remaining fields are loaded anyway
+func Load(details interface{}, defaults map[string]string) error {
+	v := reflect.ValueOf(details)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: fmt.Sprintf("unsupported tunables type: %T", details)}
+	}
+	v = v.Elem()
+
+	var invalid []string
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name, ok := field.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+		defaultValue, ok := defaults[name]
+		if !ok {
+			defaultValue = field.Tag.Get("default")
+		}
+		value := os.Getenv(name)
+		if value == "" {
+			value = defaultValue
+		}
+		if err := set(v.Field(i), field.Tag, value); err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s=%q: %v", name, value, err))
+		}
+	}
+
+	if len(invalid) != 0 {
+		return invalidTunables(invalid)
+	}
+	return nil
+}
+
+// Join merges the errors of the loads of several structs into a single error, so that every invalid tunable is reported at once
+// the nil errors are skipped, it returns nil if all of them are nil
+func Join(errs ...error) error {
+	var invalid []string
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		var tunablesErr cerrors.Error
+		if errors.As(err, &tunablesErr) && strings.HasPrefix(tunablesErr.Reason, invalidPrefix) {
+			invalid = append(invalid, strings.TrimPrefix(tunablesErr.Reason, invalidPrefix))
+			continue
+		}
+		invalid = append(invalid, err.Error())
+	}
+
+	if len(invalid) != 0 {
+		return invalidTunables(invalid)
+	}
+	return nil
+}
+
+const invalidPrefix = "invalid tunables: "
+
+func invalidTunables(invalid []string) error {
+	return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Reason: invalidPrefix + strings.Join(invalid, "; ")}
+}
+
+// set parses the given value as per the type of the field and sets it
+func set(field reflect.Value, tag reflect.StructTag, value string) error {
+	if field.Kind() == reflect.String {
+		if err := checkIntervalRange(value, tag); err != nil {
+			return err
+		}
+		if err := checkOneOf(value, tag); err != nil {
+			return err
+		}
+		field.SetString(value)
+		return nil
+	}
+
+	value = strings.TrimSpace(value)
+	switch field.Kind() {
+	case reflect.Bool:
+		if value == "" {
+			field.SetBool(false)
+			return nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("expected a boolean")
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := parseInt(value, tag.Get("unit"))
+		if err != nil {
+			return err
+		}
+		if field.OverflowInt(n) {
+			return fmt.Errorf("out of range")
+		}
+		if err := checkRange(float64(n), tag); err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		if value != "" {
+			var err error
+			if f, err = strconv.ParseFloat(value, 64); err != nil {
+				return fmt.Errorf("expected a number")
+			}
+		}
+		if err := checkRange(f, tag); err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %v", field.Type())
+	}
+	return nil
+}
+
+// parseInt parses the given integer
+// if a unit is provided, the value can also be a duration, which is converted into that unit
+func parseInt(value, unit string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err == nil {
+		return n, nil
+	}
+	if unit == "" {
+		return 0, fmt.Errorf("expected an integer")
+	}
+
+	base, ok := units[unit]
+	if !ok {
+		return 0, fmt.Errorf("unsupported unit %v", unit)
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("expected a number of %v or a duration, such as 30s", unit)
+	}
+	if d%base != 0 {
+		return 0, fmt.Errorf("expected a whole number of %v", unit)
+	}
+	return int64(d / base), nil
+}
+
+// checkRange verifies that the given value lies inside the min and max tags of the field, if any
+func checkRange(value float64, tag reflect.StructTag) error {
+	if minValue, ok := tag.Lookup("min"); ok {
+		if limit, err := strconv.ParseFloat(minValue, 64); err != nil || value < limit {
+			return fmt.Errorf("must be greater than or equal to %v", minValue)
+		}
+	}
+	if maxValue, ok := tag.Lookup("max"); ok {
+		if limit, err := strconv.ParseFloat(maxValue, 64); err != nil || value > limit {
+			return fmt.Errorf("must be less than or equal to %v", maxValue)
+		}
+	}
+	return nil
+}
+
+// checkIntervalRange verifies that the given integer or both the bounds of the given interval lie inside the range of the field
+// the interval is resolved into a random value later on, so both of its bounds must be valid
+func checkIntervalRange(value string, tag reflect.StructTag) error {
+	_, hasMin := tag.Lookup("min")
+	_, hasMax := tag.Lookup("max")
+	value = strings.TrimSpace(value)
+	if (!hasMin && !hasMax) || value == "" {
+		return nil
+	}
+
+	bounds := strings.Split(value, "-")
+	if len(bounds) > 2 {
+		return fmt.Errorf("expected an integer or an interval, such as 20-40")
+	}
+	var limits []int64
+	for _, bound := range bounds {
+		n, err := strconv.ParseInt(strings.TrimSpace(bound), 10, 64)
+		if err != nil {
+			return fmt.Errorf("expected an integer or an interval, such as 20-40")
+		}
+		if err := checkRange(float64(n), tag); err != nil {
+			return err
+		}
+		limits = append(limits, n)
+	}
+	if len(limits) == 2 && limits[0] > limits[1] {
+		return fmt.Errorf("the lower bound of the interval must not exceed its upper bound")
+	}
+	return nil
+}
+
+// checkOneOf verifies that the given value is one of the values of the oneof tag of the field, if any
+func checkOneOf(value string, tag reflect.StructTag) error {
+	values, ok := tag.Lookup("oneof")
+	if !ok {
+		return nil
+	}
+	for _, v := range strings.Split(values, ",") {
+		if value == v {
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of %v", values)
+}
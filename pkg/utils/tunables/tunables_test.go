@@ -0,0 +1,156 @@
+package tunables
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type experimentDetails struct {
+	ExperimentName string  `env:"EXPERIMENT_NAME" default:"pod-delete"`
+	ChaosDuration  int     `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	Latency        int     `env:"NETWORK_LATENCY" default:"2000" unit:"ms" min:"0"`
+	Percentage     int     `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	Force          bool    `env:"FORCE" default:"false"`
+	LoadFraction   float64 `env:"CPU_LOAD_FRACTION" default:"0.9" min:"0" max:"1"`
+	GracePeriod    int     `env:"TERMINATION_GRACE_PERIOD_SECONDS" unit:"s"`
+	TargetPods     string
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		defaults map[string]string
+		expected experimentDetails
+		invalid  []string
+	}{
+		{
+			name: "defaults",
+			expected: experimentDetails{
+				ExperimentName: "pod-delete",
+				ChaosDuration:  30,
+				Latency:        2000,
+				LoadFraction:   0.9,
+			},
+		},
+		{
+			name: "overridden defaults",
+			defaults: map[string]string{
+				"EXPERIMENT_NAME":      "kafka-broker-pod-failure",
+				"TOTAL_CHAOS_DURATION": "60",
+			},
+			expected: experimentDetails{
+				ExperimentName: "kafka-broker-pod-failure",
+				ChaosDuration:  60,
+				Latency:        2000,
+				LoadFraction:   0.9,
+			},
+		},
+		{
+			name: "durations",
+			env: map[string]string{
+				"TOTAL_CHAOS_DURATION":             "2m",
+				"NETWORK_LATENCY":                  "1.5s",
+				"TERMINATION_GRACE_PERIOD_SECONDS": " 10 ",
+				"FORCE":                            "true",
+			},
+			expected: experimentDetails{
+				ExperimentName: "pod-delete",
+				ChaosDuration:  120,
+				Latency:        1500,
+				Force:          true,
+				LoadFraction:   0.9,
+				GracePeriod:    10,
+			},
+		},
+		{
+			name: "invalid values",
+			env: map[string]string{
+				"TOTAL_CHAOS_DURATION":             "30x",
+				"NETWORK_LATENCY":                  "-1",
+				"PODS_AFFECTED_PERC":               "150",
+				"FORCE":                            "yes",
+				"CPU_LOAD_FRACTION":                "high",
+				"TERMINATION_GRACE_PERIOD_SECONDS": "500ms",
+			},
+			invalid: []string{
+				"TOTAL_CHAOS_DURATION",
+				"NETWORK_LATENCY",
+				"PODS_AFFECTED_PERC",
+				"FORCE",
+				"CPU_LOAD_FRACTION",
+				"TERMINATION_GRACE_PERIOD_SECONDS",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"EXPERIMENT_NAME", "TOTAL_CHAOS_DURATION", "NETWORK_LATENCY", "PODS_AFFECTED_PERC", "FORCE", "CPU_LOAD_FRACTION", "TERMINATION_GRACE_PERIOD_SECONDS"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			var details experimentDetails
+			err := Load(&details, tt.defaults)
+			if len(tt.invalid) != 0 {
+				require.Error(t, err)
+				cerr, ok := err.(cerrors.Error)
+				require.True(t, ok)
+				assert.Equal(t, cerrors.ErrorTypeGeneric, cerr.ErrorCode)
+				for _, name := range tt.invalid {
+					assert.Contains(t, cerr.Reason, name)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, details)
+		})
+	}
+}
+
+func TestLoadInterval(t *testing.T) {
+	type details struct {
+		Percentage string `env:"PODS_AFFECTED_PERC" default:"0" min:"0" max:"100"`
+	}
+
+	for _, value := range []string{"", "50", "20-40", " 0-100 "} {
+		t.Setenv("PODS_AFFECTED_PERC", value)
+		assert.NoError(t, Load(&details{}, nil), value)
+	}
+	for _, value := range []string{"150", "20-140", "40-20", "-5", "1-2-3", "half"} {
+		t.Setenv("PODS_AFFECTED_PERC", value)
+		assert.Error(t, Load(&details{}, nil), value)
+	}
+}
+
+func TestLoadOneOf(t *testing.T) {
+	type details struct {
+		ReportFormat string `env:"REPORT_FORMAT" default:"json" oneof:"json,junit"`
+	}
+
+	t.Setenv("REPORT_FORMAT", "junit")
+	assert.NoError(t, Load(&details{}, nil))
+	t.Setenv("REPORT_FORMAT", "")
+	assert.NoError(t, Load(&details{}, nil))
+	t.Setenv("REPORT_FORMAT", "yaml")
+	assert.Error(t, Load(&details{}, nil))
+}
+
+func TestJoin(t *testing.T) {
+	assert.NoError(t, Join(nil, nil))
+
+	first := invalidTunables([]string{`FORCE="yes": expected a boolean`})
+	second := invalidTunables([]string{`LEVEL="x": expected an integer`})
+	err := Join(first, nil, second)
+	require.Error(t, err)
+	cerr, ok := err.(cerrors.Error)
+	require.True(t, ok)
+	assert.Equal(t, cerrors.ErrorTypeGeneric, cerr.ErrorCode)
+	assert.Equal(t, `invalid tunables: FORCE="yes": expected a boolean; LEVEL="x": expected an integer`, cerr.Reason)
+}
+
+func TestLoadUnsupportedType(t *testing.T) {
+	assert.Error(t, Load(experimentDetails{}, nil))
+}
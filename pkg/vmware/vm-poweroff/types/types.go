@@ -9,21 +9,21 @@ import (
 
 // ExperimentDetails is for collecting all the experiment-related details
 type ExperimentDetails struct {
-	ExperimentName string
-	EngineName     string
-	ChaosDuration  int
-	ChaosInterval  int
-	RampTime       int
-	ChaosUID       clientTypes.UID
-	InstanceID     string
-	ChaosNamespace string
-	ChaosPodName   string
-	Timeout        int
-	Delay          int
-	Sequence       string
+	ExperimentName string          `env:"EXPERIMENT_NAME" default:"vm-poweroff"`
+	EngineName     string          `env:"CHAOSENGINE"`
+	ChaosDuration  int             `env:"TOTAL_CHAOS_DURATION" default:"30" unit:"s" min:"0"`
+	ChaosInterval  int             `env:"CHAOS_INTERVAL" default:"30" unit:"s" min:"0"`
+	RampTime       int             `env:"RAMP_TIME" unit:"s" min:"0"`
+	ChaosUID       clientTypes.UID `env:"CHAOS_UID"`
+	InstanceID     string          `env:"INSTANCE_ID"`
+	ChaosNamespace string          `env:"CHAOS_NAMESPACE" default:"litmus"`
+	ChaosPodName   string          `env:"POD_NAME"`
+	Timeout        int             `env:"STATUS_CHECK_TIMEOUT" default:"180" unit:"s" min:"0"`
+	Delay          int             `env:"STATUS_CHECK_DELAY" default:"2" unit:"s" min:"0"`
+	Sequence       string          `env:"SEQUENCE" default:"parallel"`
 	VMIds          string
 	VMTag          string
-	VcenterServer  string
-	VcenterUser    string
-	VcenterPass    string
+	VcenterServer  string `env:"VCENTERSERVER"`
+	VcenterUser    string `env:"VCENTERUSER"`
+	VcenterPass    string `env:"VCENTERPASS"`
 }
@@ -0,0 +1,205 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/cerrors"
+	"github.com/litmuschaos/litmus-go/pkg/log"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+)
+
+// Event is a lifecycle transition of the experiment
+type Event string
+
+const (
+	// Start is sent once the chaosresult is initialised
+	Start Event = "start"
+	// Inject is sent before the chaos injection
+	Inject Event = "inject"
+	// Revert is sent once the chaos injection completes, i.e, the chaos is reverted
+	Revert Event = "revert"
+	// Abort is sent once the abort signal is received
+	Abort Event = "abort"
+	// Finish is sent at the end of the experiment, along with the verdict
+	Finish Event = "finish"
+)
+
+// the headers of the webhook requests
+const (
+	EventHeader     = "X-Litmus-Event"
+	SignatureHeader = "X-Litmus-Signature"
+)
+
+// deliveryAttempts is the number of attempts to deliver an event to a webhook
+// the abort event is delivered once, as the experiment is about to exit
+const deliveryAttempts = 3
+
+var (
+	mu sync.Mutex
+	// last is closed once the last queued event is delivered, the events are delivered one after the other
+	last = func() chan struct{} {
+		c := make(chan struct{})
+		close(c)
+		return c
+	}()
+)
+
+// Payload is the body of the webhook requests
+type Payload struct {
+	Event      Event                    `json:"event"`
+	Timestamp  time.Time                `json:"timestamp"`
+	Experiment string                   `json:"experiment"`
+	Engine     string                   `json:"engine,omitempty"`
+	Namespace  string                   `json:"namespace"`
+	InstanceID string                   `json:"instanceID,omitempty"`
+	ChaosUID   string                   `json:"chaosUID,omitempty"`
+	Phase      string                   `json:"phase,omitempty"`
+	Verdict    string                   `json:"verdict,omitempty"`
+	FailStep   string                   `json:"failStep,omitempty"`
+	DryRun     bool                     `json:"dryRun,omitempty"`
+	Targets    []v1alpha1.TargetDetails `json:"targets,omitempty"`
+}
+
+// Notify queues the given event for all the webhooks of the experiment, so that the chaos isn't delayed by the deliveries
+// the deliveries are retried, the failures are logged without failing the experiment
+func Notify(event Event, chaosDetails *types.ChaosDetails, resultDetails *types.ResultDetails) {
+	urls := getURLs(chaosDetails.WebhookURLs)
+	if len(urls) == 0 {
+		return
+	}
+
+	payload := Payload{
+		Event:      event,
+		Timestamp:  time.Now().UTC(),
+		Experiment: chaosDetails.ExperimentName,
+		Engine:     chaosDetails.EngineName,
+		Namespace:  chaosDetails.ChaosNamespace,
+		InstanceID: chaosDetails.InstanceID,
+		ChaosUID:   string(chaosDetails.ChaosUID),
+		Phase:      string(chaosDetails.Phase),
+		DryRun:     chaosDetails.DryRun,
+		Targets:    chaosDetails.Targets,
+	}
+	if event == Finish || event == Abort {
+		payload.Verdict = string(resultDetails.Verdict)
+		if resultDetails.ErrorOutput != nil {
+			payload.FailStep = resultDetails.ErrorOutput.Reason
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		log.Errorf("Unable to marshal the %v webhook payload, err: %v", event, err)
+		return
+	}
+
+	attempts := deliveryAttempts
+	if event == Abort {
+		attempts = 1
+	}
+	secret, timeout := chaosDetails.WebhookSecret, time.Duration(chaosDetails.WebhookTimeout)*time.Second
+
+	mu.Lock()
+	defer mu.Unlock()
+	previous, done := last, make(chan struct{})
+	last = done
+	go func() {
+		defer close(done)
+		<-previous
+		var wg sync.WaitGroup
+		for _, url := range urls {
+			wg.Add(1)
+			go func(url string) {
+				defer wg.Done()
+				if err := send(url, event, body, secret, timeout, attempts); err != nil {
+					log.Errorf("Unable to notify the %v event, err: %v", event, err)
+				}
+			}(url)
+		}
+		wg.Wait()
+	}()
+}
+
+// Flush waits for the queued events to be delivered, it returns false if they aren't delivered within the given timeout
+func Flush(timeout time.Duration) bool {
+	mu.Lock()
+	done := last
+	mu.Unlock()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// send posts the payload to the given webhook, it is retried upon failure
+func send(url string, event Event, body []byte, secret string, timeout time.Duration, attempts int) error {
+	client := &http.Client{Timeout: timeout}
+
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt != 0 {
+			time.Sleep(time.Second)
+		}
+		if err = post(client, url, event, body, secret); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// post delivers the payload to the given webhook
+func post(client *http.Client, url string, event Event, body []byte, secret string) error {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{webhook: %s}", url), Reason: err.Error()}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(event))
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(body, secret))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{webhook: %s}", url), Reason: err.Error()}
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return cerrors.Error{ErrorCode: cerrors.ErrorTypeGeneric, Target: fmt.Sprintf("{webhook: %s}", url), Reason: fmt.Sprintf("unexpected response code: %d", resp.StatusCode)}
+	}
+	return nil
+}
+
+// Sign returns the HMAC-SHA256 signature of the given payload, in the format of the signature header
+func Sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// getURLs returns the webhooks from the comma separated list
+func getURLs(list string) []string {
+	var urls []string
+	for _, url := range strings.Split(list, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus-go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotify(t *testing.T) {
+	var received Payload
+	var signature string
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first delivery fails, so that it is retried
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &received))
+		assert.Equal(t, "finish", r.Header.Get(EventHeader))
+		signature = r.Header.Get(SignatureHeader)
+		assert.Equal(t, Sign(body, "s3cr3t"), signature)
+	}))
+	defer server.Close()
+
+	chaosDetails := &types.ChaosDetails{
+		ExperimentName: "pod-delete",
+		ChaosNamespace: "litmus",
+		WebhookURLs:    " " + server.URL + ", ",
+		WebhookSecret:  "s3cr3t",
+		WebhookTimeout: 5,
+	}
+	resultDetails := &types.ResultDetails{
+		Verdict:     v1alpha1.ResultVerdictError,
+		ErrorOutput: &v1alpha1.ErrorOutput{Reason: "could not inject chaos"},
+	}
+	Notify(Finish, chaosDetails, resultDetails)
+	require.True(t, Flush(10*time.Second))
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+	assert.NotEmpty(t, signature)
+	assert.Equal(t, Finish, received.Event)
+	assert.Equal(t, "pod-delete", received.Experiment)
+	assert.Equal(t, "Error", received.Verdict)
+	assert.Equal(t, "could not inject chaos", received.FailStep)
+}
+
+func TestNotifyOrder(t *testing.T) {
+	var mu sync.Mutex
+	var events []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the inject event is slow, the revert event is still delivered after it
+		if r.Header.Get(EventHeader) == string(Inject) {
+			time.Sleep(200 * time.Millisecond)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, r.Header.Get(EventHeader))
+	}))
+	defer server.Close()
+
+	chaosDetails := &types.ChaosDetails{ExperimentName: "pod-delete", WebhookURLs: server.URL, WebhookTimeout: 5}
+	start := time.Now()
+	Notify(Inject, chaosDetails, &types.ResultDetails{})
+	Notify(Revert, chaosDetails, &types.ResultDetails{})
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+
+	require.True(t, Flush(10*time.Second))
+	assert.Equal(t, []string{"inject", "revert"}, events)
+}